@@ -26,15 +26,19 @@ func getVersions() []string {
 	return versions
 }
 
-// NewMigrateCmd returns the Cobra command allowing to migrate config and tables to v3 version
+// NewMigrateCmd returns the Cobra command allowing to migrate config and tables.
+// The versioned schema migrations are handled by the status, up, down and plan sub-commands, while the
+// legacy major version migrations are still run by passing the version to migrate to as argument
 func NewMigrateCmd(appName string, parseConfig *parsecmdtypes.Config) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "migrate [to-version]",
 		Short: "Perform the migrations from the current version to the specified one",
 		Long: `Migrates all the necessary things (config file, database, etc) from the current version to the new one.
-Note that migrations must be performed in order: to migrate from vX to vX+2 you need to do vX -> vX+1 and then vX+1 -> vX+2. 
+Use the status, plan, up and down sub-commands to manage the versioned migrations of the database schema
+and the per-release config transforms.
+Legacy migrations must be performed in order: to migrate from vX to vX+2 you need to do vX -> vX+1 and then vX+1 -> vX+2. 
 `,
-		Example: fmt.Sprintf("%s migrate v3", appName),
+		Example: fmt.Sprintf("%s migrate v3\n%s migrate status\n%s migrate up --dry-run", appName, appName, appName),
 		Args:    cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SetOut(os.Stdout)
@@ -55,4 +59,13 @@ Note that migrations must be performed in order: to migrate from vX to vX+2 you
 			return migrator(parseConfig)
 		},
 	}
+
+	cmd.AddCommand(
+		statusCmd(parseConfig),
+		planCmd(parseConfig),
+		upCmd(parseConfig),
+		downCmd(parseConfig),
+	)
+
+	return cmd
 }
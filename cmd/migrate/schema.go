@@ -0,0 +1,231 @@
+package migrate
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"text/tabwriter"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	junodb "github.com/forbole/juno/v5/database"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	v3 "github.com/forbole/bdjuno/v4/cmd/migrate/v3"
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/database/migrate"
+	"github.com/forbole/bdjuno/v4/database/schema"
+)
+
+const (
+	flagDir      = "dir"
+	flagDryRun   = "dry-run"
+	flagTo       = "to"
+	flagBaseline = "baseline"
+	flagSteps    = "steps"
+)
+
+// configTransforms contains the changes to the config file required by each release, in the order they
+// must be applied
+var configTransforms = []migrate.ConfigTransform{
+	{Release: "v3", Description: "enable the actions module", Apply: v3.TransformConfig},
+}
+
+// statusCmd returns the Cobra command showing the applied and pending migrations
+func statusCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status",
+		Short:   "Show the applied, pending and modified migrations",
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := getMigrator(cmd, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			entries, err := migrator.Status()
+			if err != nil {
+				return err
+			}
+
+			pendingConfig, err := migrate.PlanConfigFile(config.GetConfigFilePath(), configTransforms)
+			if err != nil {
+				return err
+			}
+
+			pending := map[string]bool{}
+			for _, transform := range pendingConfig {
+				pending[transform.Release] = true
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(writer, "KIND\tVERSION\tNAME\tSTATUS\tAPPLIED AT")
+			for _, entry := range entries {
+				appliedAt := "-"
+				if entry.AppliedAt != nil {
+					appliedAt = entry.AppliedAt.UTC().Format("2006-01-02 15:04:05")
+				}
+				fmt.Fprintf(writer, "%s\t%d\t%s\t%s\t%s\n", migrate.KindSchema, entry.Version, entry.Name, entry.Status, appliedAt)
+			}
+
+			for _, transform := range configTransforms {
+				status := migrate.StatusApplied
+				if pending[transform.Release] {
+					status = migrate.StatusPending
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t-\n", migrate.KindConfig, transform.Release, transform.Description, status)
+			}
+
+			return writer.Flush()
+		},
+	}
+
+	cmd.Flags().String(flagDir, "", "Directory containing the migration files (defaults to the ones embedded inside the binary)")
+
+	return cmd
+}
+
+// planCmd returns the Cobra command listing the migrations that would be applied by the up command
+func planCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "plan",
+		Short:   "List the migrations that would be applied by the up command",
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := getMigrator(cmd, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			to, _ := cmd.Flags().GetInt(flagTo)
+			baseline, _ := cmd.Flags().GetInt(flagBaseline)
+
+			marked, pending, err := migrator.Plan(to, baseline)
+			if err != nil {
+				return err
+			}
+
+			pendingConfig, err := migrate.PlanConfigFile(config.GetConfigFilePath(), configTransforms)
+			if err != nil {
+				return err
+			}
+
+			if len(marked)+len(pending)+len(pendingConfig) == 0 {
+				cmd.Println("Everything is up to date")
+				return nil
+			}
+
+			for _, migration := range marked {
+				cmd.Printf("mark    %s\n", migration.ID())
+			}
+			for _, migration := range pending {
+				cmd.Printf("apply   %s\n", migration.ID())
+			}
+			for _, transform := range pendingConfig {
+				cmd.Printf("config  %s (%s)\n", transform.Release, transform.Description)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDir, "", "Directory containing the migration files (defaults to the ones embedded inside the binary)")
+	cmd.Flags().Int(flagTo, 0, "Version to migrate to (defaults to the latest one)")
+	cmd.Flags().Int(flagBaseline, 0, "Mark the migrations up to this version as applied without running them")
+
+	return cmd
+}
+
+// upCmd returns the Cobra command applying the pending migrations and config transforms
+func upCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply the pending migrations and config transforms",
+		Long: `Applies all the pending schema migrations, up to the given version if any, and then the pending config transforms.
+Migrations that have been modified after being applied make the command fail.
+Databases created before the migrations were tracked should be marked using the --baseline flag with the
+version of the latest schema file they contain.
+`,
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := getMigrator(cmd, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			to, _ := cmd.Flags().GetInt(flagTo)
+			baseline, _ := cmd.Flags().GetInt(flagBaseline)
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+
+			err = migrator.Up(to, baseline, dryRun)
+			if err != nil {
+				return err
+			}
+
+			// Config transforms are only applied once the whole schema is up to date
+			if to != 0 {
+				return nil
+			}
+
+			return migrator.UpConfig(config.GetConfigFilePath(), configTransforms, dryRun)
+		},
+	}
+
+	cmd.Flags().String(flagDir, "", "Directory containing the migration files (defaults to the ones embedded inside the binary)")
+	cmd.Flags().Int(flagTo, 0, "Version to migrate to (defaults to the latest one)")
+	cmd.Flags().Int(flagBaseline, 0, "Mark the migrations up to this version as applied without running them")
+	cmd.Flags().Bool(flagDryRun, false, "Print the statements instead of executing them")
+
+	return cmd
+}
+
+// downCmd returns the Cobra command reverting the latest applied migrations
+func downCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "down",
+		Short:   "Revert the latest applied migrations (config transforms are not reverted)",
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := getMigrator(cmd, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			steps, _ := cmd.Flags().GetInt(flagSteps)
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+
+			return migrator.Down(steps, dryRun)
+		},
+	}
+
+	cmd.Flags().String(flagDir, "", "Directory containing the migration files (defaults to the ones embedded inside the binary)")
+	cmd.Flags().Int(flagSteps, 1, "Number of migrations to revert")
+	cmd.Flags().Bool(flagDryRun, false, "Print the statements instead of executing them")
+
+	return cmd
+}
+
+// getMigrator builds a new Migrator reading the migrations from the directory set using the command flags,
+// or from the schema files embedded inside the binary if no directory is set
+func getMigrator(cmd *cobra.Command, parseConfig *parsecmdtypes.Config) (*migrate.Migrator, error) {
+	cmd.SetOut(os.Stdout)
+
+	var files fs.FS = schema.FS
+	if dir, _ := cmd.Flags().GetString(flagDir); dir != "" {
+		files = os.DirFS(dir)
+	}
+
+	migrations, err := migrate.LoadMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the database is needed, so there is no need to build the whole parser context
+	encodingConfig := parseConfig.GetEncodingConfigBuilder()()
+	db, err := parseConfig.GetDBBuilder()(junodb.NewContext(config.Cfg.Database, &encodingConfig, parseConfig.GetLogger()))
+	if err != nil {
+		return nil, fmt.Errorf("error while connecting to the database: %s", err)
+	}
+
+	return migrate.NewMigrator(database.Cast(db).Sqlx, migrations, os.Stdout), nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/forbole/bdjuno/v4/database/migrate"
 	"github.com/forbole/bdjuno/v4/modules/actions"
 
 	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
@@ -64,3 +65,39 @@ func migrateConfig() (Config, error) {
 
 	return cfg, nil
 }
+
+// TransformConfig enables the actions module inside the given config node, if it is not enabled yet
+func TransformConfig(cfg *yaml.Node) error {
+	chain := migrate.MappingValue(cfg, "chain")
+	if chain == nil || chain.Kind != yaml.MappingNode {
+		return fmt.Errorf("missing chain config")
+	}
+
+	modules := migrate.MappingValue(chain, "modules")
+	if modules == nil || modules.Kind != yaml.SequenceNode {
+		modules = &yaml.Node{Kind: yaml.SequenceNode}
+		migrate.SetMappingValue(chain, "modules", modules)
+	}
+
+	enabled := false
+	for _, module := range modules.Content {
+		if module.Value == actions.ModuleName {
+			enabled = true
+		}
+	}
+
+	if !enabled {
+		modules.Content = append(modules.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: actions.ModuleName})
+	}
+
+	if migrate.MappingValue(cfg, "actions") == nil {
+		var actionsCfg yaml.Node
+		err := actionsCfg.Encode(actions.NewConfig("127.0.0.1", 3000, nil))
+		if err != nil {
+			return fmt.Errorf("error while serializing actions config: %s", err)
+		}
+		migrate.SetMappingValue(cfg, "actions", &actionsCfg)
+	}
+
+	return nil
+}
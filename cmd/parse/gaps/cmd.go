@@ -0,0 +1,171 @@
+package gaps
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/parser"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	dailyrefetch "github.com/forbole/bdjuno/v4/modules/daily_refetch"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	flagStart       = "start"
+	flagEnd         = "end"
+	flagConcurrency = "concurrency"
+	flagDryRun      = "dry-run"
+
+	// gapsSource identifies the gaps reported by this command inside the database
+	gapsSource = "parse"
+)
+
+// NewGapsCmd returns the Cobra command allowing to find and refetch the blocks missing inside the database
+func NewGapsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gaps",
+		Short: "Print and refetch the ranges of blocks missing inside the database",
+		Long: fmt.Sprintf(`Find the ranges of heights missing inside the database and refetch them, storing a report of each range.
+You can specify a custom height range by using the %s and %s flags, and only print the ranges by using the %s flag.
+`, flagStart, flagEnd, flagDryRun),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Get the flag values
+			start, _ := cmd.Flags().GetInt64(flagStart)
+			end, _ := cmd.Flags().GetInt64(flagEnd)
+			concurrency, _ := cmd.Flags().GetInt(flagConcurrency)
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+
+			// Get the start height, default to the config's height; use flagStart if set
+			startHeight := config.Cfg.Parser.StartHeight
+			if start > 0 {
+				startHeight = start
+			}
+			if startHeight < 1 {
+				startHeight = 1
+			}
+
+			// Get the end height, default to the latest stored height; use flagEnd if set
+			endHeight, err := db.GetLastBlockHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting latest stored block height: %s", err)
+			}
+			if end > 0 {
+				endHeight = end
+			}
+
+			if startHeight > endHeight {
+				return fmt.Errorf("invalid height range: %d > %d", startHeight, endHeight)
+			}
+
+			// Get the concurrency, default to the daily refetch one
+			if concurrency <= 0 {
+				bz, err := config.Cfg.GetBytes()
+				if err != nil {
+					return err
+				}
+
+				refetchCfg, err := dailyrefetch.ParseConfig(bz)
+				if err != nil {
+					return err
+				}
+				concurrency = refetchCfg.Concurrency
+			}
+
+			scanned := types.NewBlockGap(startHeight, endHeight)
+			gaps, err := db.GetBlockGaps(scanned.StartHeight, scanned.EndHeight)
+			if err != nil {
+				return err
+			}
+
+			err = printGaps(gaps)
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				return nil
+			}
+
+			// Close the reports of the gaps filled in the meantime
+			if len(gaps) == 0 {
+				return db.SaveBlockGapReports(scanned, time.Now().UTC(), nil)
+			}
+
+			workerCtx := parser.NewContext(parseCtx.EncodingConfig, parseCtx.Node, parseCtx.Database, parseCtx.Logger, parseCtx.Modules)
+			reports, fillErr := dailyrefetch.FillGaps(workerCtx, db, gapsSource, scanned, gaps, concurrency)
+
+			err = printReports(reports)
+			if err != nil {
+				return err
+			}
+
+			return fillErr
+		},
+	}
+
+	cmd.Flags().Int64(flagStart, 0, "Height from which to start looking for missing blocks. If 0, the start height inside the config file will be used instead")
+	cmd.Flags().Int64(flagEnd, 0, "Height at which to finish looking for missing blocks. If 0, the latest height stored inside the database will be used instead")
+	cmd.Flags().Int(flagConcurrency, 0, "Maximum number of blocks refetched at the same time. If 0, the daily refetch concurrency inside the config file will be used instead")
+	cmd.Flags().Bool(flagDryRun, false, "Only print the missing blocks without refetching them")
+
+	return cmd
+}
+
+// printGaps prints the given gaps as a table followed by the number of missing blocks
+func printGaps(gaps []types.BlockGap) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "START\tEND\tMISSING")
+
+	var missing int64
+	for _, gap := range gaps {
+		fmt.Fprintf(w, "%d\t%d\t%d\n", gap.StartHeight, gap.EndHeight, gap.Size())
+		missing += gap.Size()
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d missing blocks in %d gaps\n", missing, len(gaps))
+	return nil
+}
+
+// printReports prints the gaps that could not be filled, followed by the number of refetched blocks
+func printReports(reports []types.BlockGapReport) error {
+	var total, failed int64
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, report := range reports {
+		total += report.Size()
+		if report.FilledAt != nil {
+			continue
+		}
+
+		if failed == 0 {
+			fmt.Fprintln(w, "\nSTART\tEND\tSTILL MISSING\tERROR")
+		}
+		failed += report.Missing
+		fmt.Fprintf(w, "%d\t%d\t%d\t%s\n", report.StartHeight, report.EndHeight, report.Missing, report.Error)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d of %d missing blocks refetched\n", total-failed, total)
+	return nil
+}
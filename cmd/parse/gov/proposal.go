@@ -52,7 +52,7 @@ func proposalCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 			distrModule := distribution.NewModule(sources.DistrSource, parseCtx.EncodingConfig.Codec, db)
 			mintModule := mint.NewModule(sources.MintSource, parseCtx.EncodingConfig.Codec, db)
 			slashingModule := slashing.NewModule(sources.SlashingSource, parseCtx.EncodingConfig.Codec, db)
-			stakingModule := staking.NewModule(sources.StakingSource, parseCtx.EncodingConfig.Codec, db)
+			stakingModule := staking.NewModule(config.Cfg, sources.StakingSource, parseCtx.EncodingConfig.Codec, db)
 
 			// Build the gov module
 			govModule := gov.NewModule(sources.GovSource, distrModule, mintModule, slashingModule, stakingModule, parseCtx.EncodingConfig.Codec, db)
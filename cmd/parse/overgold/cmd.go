@@ -0,0 +1,26 @@
+package overgold
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// NewOverGoldCmd returns the Cobra command allowing to fix various things related to the OverGold modules
+func NewOverGoldCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "overgold",
+		Short: "Fix things related to the OverGold modules",
+	}
+
+	cmd.AddCommand(
+		genesisCmd(parseConfig),
+		stakeFlowsCmd(parseConfig),
+		stateCmd(parseConfig),
+	)
+
+	for _, name := range moduleNames() {
+		cmd.AddCommand(messagesCmd(name, parseConfig))
+	}
+
+	return cmd
+}
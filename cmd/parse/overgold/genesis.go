@@ -0,0 +1,72 @@
+package overgold
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/forbole/juno/v5/types/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+const (
+	flagGenesisPath = "genesis-file-path"
+)
+
+// genesisCmd returns the Cobra command allowing to re-import the OverGold genesis state only
+func genesisCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "Re-import the OverGold modules state from the genesis file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Get the file path
+			genesisFilePath := config.Cfg.Parser.GenesisFilePath
+			customPath, _ := cmd.Flags().GetString(flagGenesisPath)
+			if customPath != "" {
+				genesisFilePath = customPath
+			}
+
+			// Read the genesis file
+			genDoc, err := utils.ReadGenesisFileGenesisDoc(genesisFilePath)
+			if err != nil {
+				return err
+			}
+
+			genState, err := utils.GetGenesisState(genDoc)
+			if err != nil {
+				return err
+			}
+
+			modules := buildModules(sources, parseCtx.EncodingConfig.Codec, db)
+			for _, name := range moduleNames() {
+				err = modules[name].HandleGenesis(genDoc, genState)
+				if err != nil {
+					return fmt.Errorf("error while handling OverGold %s genesis: %s", name, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesisPath, "", "Path to the genesis file to be used. If empty, the path will be taken from the config file")
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package overgold
+
+import (
+	"errors"
+	"fmt"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+const (
+	flagStart = "start"
+	flagEnd   = "end"
+)
+
+// messagesCmd returns the Cobra command allowing to re-run the message handlers of the given OverGold sub-module
+func messagesCmd(name string, parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   name,
+		Short: fmt.Sprintf("Re-parse the stored transactions using the OverGold %s module handlers", name),
+		Long: fmt.Sprintf(`Re-run the OverGold %s module handlers over the messages of the successful transactions stored inside the database.
+You can specify a custom height range by using the %s and %s flags.
+`, name, flagStart, flagEnd),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Get the flag values
+			start, _ := cmd.Flags().GetInt64(flagStart)
+			end, _ := cmd.Flags().GetInt64(flagEnd)
+
+			// Get the start height, default to the config's height; use flagStart if set
+			startHeight := config.Cfg.Parser.StartHeight
+			if start > 0 {
+				startHeight = start
+			}
+
+			// Get the end height, default to the latest stored height; use flagEnd if set
+			endHeight, err := db.GetLastBlockHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting latest stored block height: %s", err)
+			}
+			if end > 0 {
+				endHeight = end
+			}
+
+			if startHeight > endHeight {
+				return fmt.Errorf("invalid height range: %d > %d", startHeight, endHeight)
+			}
+
+			module := buildModules(sources, parseCtx.EncodingConfig.Codec, db)[name]
+			return parseMessages(module, parseCtx.EncodingConfig.Codec, db, startHeight, endHeight)
+		},
+	}
+
+	cmd.Flags().Int64(flagStart, 0, "Height from which to start re-parsing transactions. If 0, the start height inside the config file will be used instead")
+	cmd.Flags().Int64(flagEnd, 0, "Height at which to finish re-parsing transactions. If 0, the latest height stored inside the database will be used instead")
+
+	return cmd
+}
+
+// parseMessages runs the message handlers of the given module over the successful transactions stored between
+// the given heights
+func parseMessages(module overgoldModule, cdc codec.Codec, db *database.Db, startHeight, endHeight int64) error {
+	heights, err := db.GetTransactionsHeights(startHeight, endHeight)
+	if err != nil {
+		return fmt.Errorf("error while getting transactions heights: %s", err)
+	}
+
+	log.Info().Str("module", module.Name()).Int64("start height", startHeight).Int64("end height", endHeight).
+		Int("heights", len(heights)).Msg("re-parsing transactions...")
+
+	for _, height := range heights {
+		txs, err := db.GetTransactions(filter.NewFilter().SetArgument(dbtypes.FieldHeight, height))
+		if err != nil {
+			if errors.As(err, &errs.NotFound{}) {
+				continue
+			}
+			return fmt.Errorf("error while getting transactions of height %d: %s", height, err)
+		}
+
+		log.Debug().Str("module", module.Name()).Int64("height", height).Msg("processing transactions...")
+
+		for _, tx := range txs {
+			if !tx.Successful() {
+				continue
+			}
+
+			for index, msg := range tx.Body.Messages {
+				var stdMsg sdk.Msg
+				if err = cdc.UnpackAny(msg, &stdMsg); err != nil {
+					return fmt.Errorf("error while unpacking message: %s", err)
+				}
+
+				err = module.HandleMsg(index, stdMsg, tx)
+				if err != nil && !errors.As(err, &errs.NotFound{}) {
+					return fmt.Errorf("error while handling message %d of transaction %s: %s", index, tx.TxHash, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
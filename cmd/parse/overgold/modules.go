@@ -0,0 +1,60 @@
+package overgold
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	jmodules "github.com/forbole/juno/v5/modules"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/allowed"
+	customBank "github.com/forbole/bdjuno/v4/modules/overgold/chain/bank"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/core"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/feeexcluder"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/referral"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/stake"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	moduleAllowed     = "allowed"
+	moduleBank        = "bank"
+	moduleCore        = "core"
+	moduleFeeExcluder = "feeexcluder"
+	moduleReferral    = "referral"
+	moduleStake       = "stake"
+)
+
+// overgoldModule represents an OverGold sub-module that can be re-run from the parse commands
+type overgoldModule interface {
+	jmodules.Module
+	jmodules.GenesisModule
+	jmodules.MessageModule
+}
+
+// stateModule represents an OverGold sub-module whose stored state can be refreshed from the node
+type stateModule interface {
+	RefreshState(height int64, overwrite bool) ([]types.OverGoldStateDiff, error)
+}
+
+// buildModules returns the OverGold sub-modules indexed by the name used inside the parse commands.
+// The sub-modules are built directly so that the block scheduler of the OverGold module is not involved
+func buildModules(sources *modulestypes.Sources, cdc codec.Codec, db *database.Db) map[string]overgoldModule {
+	return map[string]overgoldModule{
+		moduleAllowed:     allowed.NewModule(sources.OverGoldAllowedSource, cdc, db),
+		moduleBank:        customBank.NewModule(sources.OverGoldBankSource, cdc, db),
+		moduleCore:        core.NewModule(sources.OverGoldCoreSource, cdc, db),
+		moduleFeeExcluder: feeexcluder.NewModule(sources.OverGoldFeeExcluderSource, cdc, db),
+		moduleReferral:    referral.NewModule(sources.OverGoldReferralSource, cdc, db),
+		moduleStake:       stake.NewModule(sources.OverGoldStakeSource, cdc, db),
+	}
+}
+
+// moduleNames returns the names of the OverGold sub-modules, sorted alphabetically
+func moduleNames() []string {
+	return []string{moduleAllowed, moduleBank, moduleCore, moduleFeeExcluder, moduleReferral, moduleStake}
+}
+
+// stateModuleNames returns the names of the OverGold sub-modules whose state can be refreshed, sorted alphabetically
+func stateModuleNames() []string {
+	return []string{moduleAllowed, moduleFeeExcluder, moduleReferral, moduleStake}
+}
@@ -0,0 +1,73 @@
+package overgold
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/stake"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+// stakeFlowsCmd returns the Cobra command allowing to rebuild the stake transfer flows
+func stakeFlowsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake-flows",
+		Short: "Rebuild the stake transfer flows of the transfers stored inside the database",
+		Long: fmt.Sprintf(`Rebuild the stake transfer flows starting from the messages of the successful transactions stored inside the database.
+You can specify a custom height range by using the %s and %s flags.
+`, flagStart, flagEnd),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Get the flag values
+			start, _ := cmd.Flags().GetInt64(flagStart)
+			end, _ := cmd.Flags().GetInt64(flagEnd)
+
+			// Get the start height, default to the config's height; use flagStart if set
+			startHeight := config.Cfg.Parser.StartHeight
+			if start > 0 {
+				startHeight = start
+			}
+
+			// Get the end height, default to the latest stored height; use flagEnd if set
+			endHeight, err := db.GetLastBlockHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting latest stored block height: %s", err)
+			}
+			if end > 0 {
+				endHeight = end
+			}
+
+			// Build stake module
+			stakeModule := stake.NewModule(sources.OverGoldStakeSource, parseCtx.EncodingConfig.Codec, db)
+
+			err = stakeModule.RebuildTransferFlows(startHeight, endHeight)
+			if err != nil {
+				return fmt.Errorf("error while rebuilding stake transfer flows: %s", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagStart, 0, "Height from which to start rebuilding the flows. If 0, the start height inside the config file will be used instead")
+	cmd.Flags().Int64(flagEnd, 0, "Height at which to finish rebuilding the flows. If 0, the latest height stored inside the database will be used instead")
+
+	return cmd
+}
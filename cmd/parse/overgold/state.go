@@ -0,0 +1,116 @@
+package overgold
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	flagHeight    = "height"
+	flagOverwrite = "overwrite"
+	flagModules   = "modules"
+)
+
+// stateCmd returns the Cobra command allowing to compare the stored OverGold state with the node one
+func stateCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Compare the stored OverGold state with the node one, optionally overwriting the differences",
+		Long: fmt.Sprintf(`Read the current fee excluder tariffs and addresses, allowed addresses, referral links and stakes
+from the node and compare them with the ones stored inside the database, reporting the differences.
+You can specify a custom height by using the %s flag, and overwrite the stored state by using the %s flag.
+`, flagHeight, flagOverwrite),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Get the flag values
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+			overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+			names, _ := cmd.Flags().GetStringSlice(flagModules)
+
+			// Get the height, default to the latest stored height
+			if height == 0 {
+				height, err = db.GetLastBlockHeight()
+				if err != nil {
+					return fmt.Errorf("error while getting latest stored block height: %s", err)
+				}
+			}
+
+			modules := buildModules(sources, parseCtx.EncodingConfig.Codec, db)
+
+			var diffs []types.OverGoldStateDiff
+			for _, name := range names {
+				module, ok := modules[name].(stateModule)
+				if !ok {
+					return fmt.Errorf("invalid module %s, the state can be refreshed only for: %s",
+						name, strings.Join(stateModuleNames(), ", "))
+				}
+
+				moduleDiffs, err := module.RefreshState(height, overwrite)
+				if err != nil {
+					return fmt.Errorf("error while refreshing OverGold %s state: %s", name, err)
+				}
+
+				diffs = append(diffs, moduleDiffs...)
+			}
+
+			return printStateDiffs(height, overwrite, diffs)
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height at which to read the node state. If 0, the latest height stored inside the database will be used instead")
+	cmd.Flags().Bool(flagOverwrite, false, "Overwrite the stored state with the node one instead of only reporting the differences")
+	cmd.Flags().StringSlice(flagModules, stateModuleNames(), "OverGold modules whose state should be compared")
+
+	return cmd
+}
+
+// printStateDiffs prints the given differences as a table followed by a summary
+func printStateDiffs(height int64, overwrite bool, diffs []types.OverGoldStateDiff) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODULE\tKEY\tDIFF\tINDEXED\tNODE")
+	for _, diff := range diffs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", diff.Module, diff.Key, diff.Kind, diff.Indexed, diff.Node)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	counts := make(map[string]int)
+	for _, diff := range diffs {
+		counts[diff.Kind]++
+	}
+
+	action := "found"
+	if overwrite {
+		action = "overwritten"
+	}
+
+	fmt.Printf("\n%d differences %s at height %d: %d missing, %d stale, %d changed\n", len(diffs), action, height,
+		counts[types.StateDiffMissing], counts[types.StateDiffStale], counts[types.StateDiffChanged])
+
+	return nil
+}
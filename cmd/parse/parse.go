@@ -14,8 +14,10 @@ import (
 	parsebank "github.com/forbole/bdjuno/v4/cmd/parse/bank"
 	parsedistribution "github.com/forbole/bdjuno/v4/cmd/parse/distribution"
 	parsefeegrant "github.com/forbole/bdjuno/v4/cmd/parse/feegrant"
+	parsegaps "github.com/forbole/bdjuno/v4/cmd/parse/gaps"
 	parsegov "github.com/forbole/bdjuno/v4/cmd/parse/gov"
 	parsemint "github.com/forbole/bdjuno/v4/cmd/parse/mint"
+	parseovergold "github.com/forbole/bdjuno/v4/cmd/parse/overgold"
 	parsepricefeed "github.com/forbole/bdjuno/v4/cmd/parse/pricefeed"
 	parsestaking "github.com/forbole/bdjuno/v4/cmd/parse/staking"
 )
@@ -34,9 +36,11 @@ func NewParseCmd(parseCfg *parse.Config) *cobra.Command {
 		parseblocks.NewBlocksCmd(parseCfg),
 		parsedistribution.NewDistributionCmd(parseCfg),
 		parsefeegrant.NewFeegrantCmd(parseCfg),
+		parsegaps.NewGapsCmd(parseCfg),
 		parsegenesis.NewGenesisCmd(parseCfg),
 		parsegov.NewGovCmd(parseCfg),
 		parsemint.NewMintCmd(parseCfg),
+		parseovergold.NewOverGoldCmd(parseCfg),
 		parsepricefeed.NewPricefeedCmd(parseCfg),
 		parsestaking.NewStakingCmd(parseCfg),
 		parsetransaction.NewTransactionsCmd(parseCfg),
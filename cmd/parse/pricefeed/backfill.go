@@ -0,0 +1,71 @@
+package pricefeed
+
+import (
+	"fmt"
+	"time"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/pricefeed"
+)
+
+// backfillCmd returns the Cobra command allowing to backfill the token price history and candles
+func backfillCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "backfill [from] [to]",
+		Short: "Store the token price history and OHLC candles between the given dates (YYYY-MM-DD or RFC 3339)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := parseDate(args[0])
+			if err != nil {
+				return err
+			}
+
+			to, err := parseDate(args[1])
+			if err != nil {
+				return err
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build pricefeed module
+			pricefeedModule := pricefeed.NewModule(config.Cfg, parseCtx.EncodingConfig.Codec, db)
+
+			err = pricefeedModule.RunAdditionalOperations()
+			if err != nil {
+				return fmt.Errorf("error while storing tokens: %s", err)
+			}
+
+			err = pricefeedModule.Backfill(from, to)
+			if err != nil {
+				return fmt.Errorf("error while backfilling price history: %s", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// parseDate parses the given date, expressed either as YYYY-MM-DD or RFC 3339
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err == nil {
+		return date, nil
+	}
+
+	date, err = time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %s: expected YYYY-MM-DD or RFC 3339", value)
+	}
+
+	return date, nil
+}
@@ -15,6 +15,7 @@ func NewPricefeedCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 	cmd.AddCommand(
 		priceCmd(parseConfig),
 		priceHistoryCmd(parseConfig),
+		backfillCmd(parseConfig),
 	)
 
 	return cmd
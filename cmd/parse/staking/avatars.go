@@ -0,0 +1,46 @@
+package staking
+
+import (
+	"fmt"
+
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/staking"
+)
+
+// avatarsCmd returns a Cobra command that allows to resolve again the avatars of all validators.
+func avatarsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "avatars",
+		Short: "Resolve again the avatars of all the validators using the configured resolvers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the staking module
+			stakingModule := staking.NewModule(config.Cfg, sources.StakingSource, parseCtx.EncodingConfig.Codec, db)
+
+			err = stakingModule.RefreshValidatorsAvatars()
+			if err != nil {
+				return fmt.Errorf("error while refreshing validators avatars: %s", err)
+			}
+
+			return nil
+		},
+	}
+}
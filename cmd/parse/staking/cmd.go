@@ -15,6 +15,7 @@ func NewStakingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 	cmd.AddCommand(
 		poolCmd(parseConfig),
 		validatorsCmd(parseConfig),
+		avatarsCmd(parseConfig),
 	)
 
 	return cmd
@@ -33,7 +33,7 @@ func validatorsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 			db := database.Cast(parseCtx.Database)
 
 			// Build the staking module
-			stakingModule := staking.NewModule(sources.StakingSource, parseCtx.EncodingConfig.Codec, db)
+			stakingModule := staking.NewModule(config.Cfg, sources.StakingSource, parseCtx.EncodingConfig.Codec, db)
 
 			// Get latest height
 			height, err := parseCtx.Node.LatestHeight()
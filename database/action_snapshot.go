@@ -0,0 +1,52 @@
+package database
+
+import (
+	"fmt"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// SaveActionSnapshot stores the given action snapshot, unless a snapshot of the same request
+// read at a greater height is already stored
+func (db *Db) SaveActionSnapshot(snapshot types.ActionSnapshot) error {
+	stmt := `
+INSERT INTO action_snapshot (path, request_key, response, height, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (path, request_key) DO UPDATE
+    SET response = excluded.response,
+        height = excluded.height,
+        updated_at = excluded.updated_at
+WHERE action_snapshot.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt,
+		snapshot.Path,
+		snapshot.RequestKey,
+		string(snapshot.Response),
+		snapshot.Height,
+		snapshot.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing action snapshot: %s", err)
+	}
+
+	return nil
+}
+
+// GetActionSnapshot returns the snapshot stored for the action request having the given path and key,
+// or nil if no snapshot has been stored yet
+func (db *Db) GetActionSnapshot(path, requestKey string) (*types.ActionSnapshot, error) {
+	var rows []dbtypes.ActionSnapshotRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM action_snapshot WHERE path = $1 AND request_key = $2`, path, requestKey)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting action snapshot: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	row := rows[0]
+	snapshot := types.NewActionSnapshot(row.Path, row.RequestKey, row.Response, row.Height, row.UpdatedAt)
+	return &snapshot, nil
+}
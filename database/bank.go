@@ -1,6 +1,8 @@
 package database
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 
 	dbtypes "github.com/forbole/bdjuno/v4/database/types"
@@ -26,3 +28,21 @@ WHERE supply.height <= excluded.height`
 
 	return nil
 }
+
+// GetSupply returns the latest total supply stored inside the database, or nil if it has not been stored yet
+func (db *Db) GetSupply() (sdk.Coins, error) {
+	var row dbtypes.SupplyRow
+	err := db.Sqlx.Get(&row, `SELECT * FROM supply`)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error while getting supply: %s", err)
+	}
+
+	if row.Coins == nil {
+		return nil, nil
+	}
+
+	return row.Coins.ToCoins(), nil
+}
@@ -1,17 +1,128 @@
 package database
 
-// GetMissingBlocks returns an array of missing blocks from one day ago
-func (db *Db) GetMissingBlocks(startHeight, endHeight int64) []int64 {
-	var result []int64
-	stmt := `SELECT generate_series($1::int,$2::int) EXCEPT SELECT height FROM block ORDER BY 1;`
-	err := db.Sqlx.Select(&result, stmt, startHeight, endHeight)
+import (
+	"fmt"
+	"time"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// GetBlockGaps returns the ranges of heights between startHeight and endHeight, both included,
+// that are missing from the block table
+func (db *Db) GetBlockGaps(startHeight, endHeight int64) ([]types.BlockGap, error) {
+	stmt := `
+SELECT height + 1 AS start_height, next_height - 1 AS end_height
+FROM (
+    SELECT height, LEAD(height) OVER (ORDER BY height) AS next_height
+    FROM (
+        SELECT height FROM block WHERE height BETWEEN $1 AND $2
+        UNION SELECT $1::BIGINT - 1
+        UNION SELECT $2::BIGINT + 1
+    ) AS heights
+) AS consecutive
+WHERE next_height > height + 1
+ORDER BY start_height`
+
+	var rows []dbtypes.BlockGapRow
+	err := db.Sqlx.Select(&rows, stmt, startHeight, endHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting block gaps: %s", err)
+	}
+
+	gaps := make([]types.BlockGap, len(rows))
+	for i, row := range rows {
+		gaps[i] = types.NewBlockGap(row.StartHeight, row.EndHeight)
+	}
+
+	return gaps, nil
+}
+
+// GetBlockHeightBefore returns the height of the latest block stored with a timestamp
+// not after the given one
+func (db *Db) GetBlockHeightBefore(timestamp time.Time) (int64, error) {
+	block, err := db.getBlockHeightTime(timestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	return block.Height, nil
+}
+
+// SaveBlockGapReports stores the reports of the gaps found while scanning the given range of heights,
+// updating the ones of the gaps already detected. The open reports overlapping a new gap are merged into it,
+// keeping the time at which they have been first detected, while the other open reports inside the scanned
+// range are closed, since none of their heights is missing anymore.
+func (db *Db) SaveBlockGapReports(scanned types.BlockGap, checkedAt time.Time, reports []types.BlockGapReport) error {
+	mergeStmt := `
+DELETE FROM block_gap
+WHERE filled_at IS NULL
+  AND start_height <= $2 AND end_height >= $1
+  AND (start_height, end_height) <> ($1, $2)
+RETURNING first_detected_at`
+
+	upsertStmt := `
+INSERT INTO block_gap (start_height, end_height, source, missing, error, first_detected_at, last_checked_at, filled_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (start_height, end_height) DO UPDATE
+    SET source = excluded.source,
+        missing = excluded.missing,
+        error = excluded.error,
+        first_detected_at = LEAST(block_gap.first_detected_at, excluded.first_detected_at),
+        last_checked_at = excluded.last_checked_at,
+        filled_at = excluded.filled_at`
+
+	closeStmt := `
+UPDATE block_gap
+SET missing = 0, error = NULL, last_checked_at = $3, filled_at = $3
+WHERE filled_at IS NULL
+  AND start_height >= $1 AND end_height <= $2
+  AND last_checked_at < $3`
+
+	tx, err := db.Sqlx.Beginx()
 	if err != nil {
-		return nil
+		return fmt.Errorf("error while starting transaction: %s", err)
 	}
+	defer tx.Rollback() //nolint:errcheck
+
+	for _, report := range reports {
+		var merged []time.Time
+		err = tx.Select(&merged, mergeStmt, report.StartHeight, report.EndHeight)
+		if err != nil {
+			return fmt.Errorf("error while merging block gap %d-%d: %s", report.StartHeight, report.EndHeight, err)
+		}
+
+		firstDetectedAt := report.CheckedAt
+		for _, detectedAt := range merged {
+			if detectedAt.Before(firstDetectedAt) {
+				firstDetectedAt = detectedAt
+			}
+		}
 
-	if len(result) == 0 {
-		return nil
+		_, err = tx.Exec(upsertStmt,
+			report.StartHeight,
+			report.EndHeight,
+			report.Source,
+			report.Missing,
+			dbtypes.ToNullString(report.Error),
+			firstDetectedAt,
+			report.CheckedAt,
+			report.FilledAt,
+		)
+		if err != nil {
+			return fmt.Errorf("error while storing block gap %d-%d: %s", report.StartHeight, report.EndHeight, err)
+		}
+	}
+
+	_, err = tx.Exec(closeStmt, scanned.StartHeight, scanned.EndHeight, checkedAt)
+	if err != nil {
+		return fmt.Errorf("error while closing block gaps %d-%d: %s", scanned.StartHeight, scanned.EndHeight, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("error while committing block gaps: %s", err)
 	}
 
-	return result
+	return nil
 }
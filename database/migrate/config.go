@@ -0,0 +1,174 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigReleasesKey is the key of the config file listing the releases whose transforms have been applied to it
+const ConfigReleasesKey = "config_releases"
+
+// ConfigTransform represents a change to the config file that is required by a given release
+type ConfigTransform struct {
+	Release     string
+	Description string
+
+	// Apply modifies in place the given config, which is the mapping node at the root of the config file.
+	// It must leave the config untouched when the change is already there, so that configs written by hand
+	// for the new release are not broken
+	Apply func(cfg *yaml.Node) error
+}
+
+// PlanConfig returns the transforms that have not been applied yet to the YAML config contained inside bz,
+// keeping their order
+func PlanConfig(bz []byte, transforms []ConfigTransform) ([]ConfigTransform, error) {
+	document, err := parseConfig(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]bool)
+	if releases := MappingValue(document.Content[0], ConfigReleasesKey); releases != nil {
+		for _, release := range releases.Content {
+			applied[release.Value] = true
+		}
+	}
+
+	var plan []ConfigTransform
+	for _, transform := range transforms {
+		if !applied[transform.Release] {
+			plan = append(plan, transform)
+		}
+	}
+	return plan, nil
+}
+
+// PlanConfigFile returns the transforms that have not been applied yet to the config file at the given path
+func PlanConfigFile(path string, transforms []ConfigTransform) ([]ConfigTransform, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading config file: %s", err)
+	}
+	return PlanConfig(bz, transforms)
+}
+
+// ApplyConfigTransforms applies the given transforms, in order, to the YAML config contained inside bz,
+// recording their releases inside the config itself. Comments and keys order are preserved.
+func ApplyConfigTransforms(bz []byte, transforms []ConfigTransform) ([]byte, error) {
+	document, err := parseConfig(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	root := document.Content[0]
+	releases := MappingValue(root, ConfigReleasesKey)
+	if releases == nil {
+		releases = &yaml.Node{Kind: yaml.SequenceNode}
+		SetMappingValue(root, ConfigReleasesKey, releases)
+	}
+
+	for _, transform := range transforms {
+		err = transform.Apply(root)
+		if err != nil {
+			return nil, fmt.Errorf("error while applying %s config transform: %s", transform.Release, err)
+		}
+
+		release := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: transform.Release}
+		releases.Content = append(releases.Content, release)
+	}
+
+	out, err := yaml.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("error while serializing config: %s", err)
+	}
+
+	return out, nil
+}
+
+// parseConfig returns the document node of the YAML config contained inside bz,
+// whose only content is the mapping node at the root of the config
+func parseConfig(bz []byte) (*yaml.Node, error) {
+	var document yaml.Node
+	err := yaml.Unmarshal(bz, &document)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading config: %s", err)
+	}
+
+	if len(document.Content) == 0 {
+		return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}, nil
+	}
+
+	if document.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("error while reading config: not a mapping")
+	}
+	return &document, nil
+}
+
+// MappingValue returns the value associated with the given key inside the given mapping node, if any
+func MappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// SetMappingValue sets the value associated with the given key inside the given mapping node,
+// appending the key after the existing ones if it is not there yet
+func SetMappingValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// UpConfig applies the pending transforms to the config file at the given path.
+// The original file is kept as a backup next to the new one.
+// When dryRun is true, the transforms are only listed without touching the file
+func (m *Migrator) UpConfig(path string, transforms []ConfigTransform, dryRun bool) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error while reading config file: %s", err)
+	}
+
+	pending, err := PlanConfig(bz, transforms)
+	if err != nil {
+		return err
+	}
+
+	for _, transform := range pending {
+		fmt.Fprintf(m.out, "-- config %s: %s\n", transform.Release, transform.Description)
+	}
+
+	if dryRun || len(pending) == 0 {
+		return nil
+	}
+
+	out, err := ApplyConfigTransforms(bz, pending)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(path+".bak", bz, 0600)
+	if err != nil {
+		return fmt.Errorf("error while writing config backup: %s", err)
+	}
+
+	err = os.WriteFile(path, out, 0600)
+	if err != nil {
+		return fmt.Errorf("error while writing config file: %s", err)
+	}
+
+	return nil
+}
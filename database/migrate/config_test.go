@@ -0,0 +1,51 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestApplyConfigTransforms(t *testing.T) {
+	transforms := []ConfigTransform{
+		{
+			Release: "v1",
+			Apply: func(cfg *yaml.Node) error {
+				SetMappingValue(cfg, "foo", &yaml.Node{Kind: yaml.ScalarNode, Value: "bar"})
+				return nil
+			},
+		},
+		{
+			Release: "v2",
+			Apply: func(cfg *yaml.Node) error {
+				foo := MappingValue(cfg, "foo")
+				foo.Value += "-baz"
+				return nil
+			},
+		},
+	}
+
+	pending, err := PlanConfig([]byte("chain:\n  bech32_prefix: cosmos\nconfig_releases:\n  - v1\n"), transforms)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "v2", pending[0].Release)
+
+	original := "# Chain settings\nchain:\n  bech32_prefix: cosmos # Accounts prefix\nlogging:\n  level: debug\n"
+	bz, err := ApplyConfigTransforms([]byte(original), transforms)
+	require.NoError(t, err)
+	require.Equal(t, `# Chain settings
+chain:
+    bech32_prefix: cosmos # Accounts prefix
+logging:
+    level: debug
+config_releases:
+    - v1
+    - v2
+foo: bar-baz
+`, string(bz))
+
+	pending, err = PlanConfig(bz, transforms)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
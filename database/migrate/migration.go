@@ -0,0 +1,182 @@
+package migrate
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DirectionUp identifies the section of a migration that applies it
+	DirectionUp = "Up"
+
+	// DirectionDown identifies the section of a migration that reverts it
+	DirectionDown = "Down"
+
+	// markerPrefix is the prefix of the comments splitting a file into its sections.
+	// It is the same one used by sql-migrate so that the existing schema files can be used as they are
+	markerPrefix = "-- +migrate "
+
+	// optionNoTransaction tells that a section must be executed outside a transaction
+	optionNoTransaction = "notransaction"
+)
+
+// Migration represents a single versioned SQL file
+type Migration struct {
+	Version  int
+	Name     string
+	Checksum string
+
+	Up              string
+	UpNoTransaction bool
+
+	Down              string
+	DownNoTransaction bool
+}
+
+// ID returns the file-like identifier of the migration
+func (m *Migration) ID() string {
+	return fmt.Sprintf("%02d-%s", m.Version, m.Name)
+}
+
+// SQL returns the statements of the given direction, and whether they must run outside a transaction
+func (m *Migration) SQL(direction string) (string, bool) {
+	if direction == DirectionDown {
+		return m.Down, m.DownNoTransaction
+	}
+	return m.Up, m.UpNoTransaction
+}
+
+// Checksum returns the hex-encoded SHA-256 of the given file contents
+func Checksum(bz []byte) string {
+	sum := sha256.Sum256(bz)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseMigration builds a Migration from the given file name and contents.
+// The name must be in the NN-name.sql form, where NN is the version of the migration
+func ParseMigration(fileName string, bz []byte) (*Migration, error) {
+	version, name, err := parseFileName(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	migration := &Migration{
+		Version:  version,
+		Name:     name,
+		Checksum: Checksum(bz),
+	}
+
+	var up, down strings.Builder
+	var current *strings.Builder
+
+	scanner := bufio.NewScanner(strings.NewReader(string(bz)))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(bz)+1)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, markerPrefix) {
+			if current != nil {
+				current.WriteString(line)
+				current.WriteString("\n")
+			}
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(trimmed, markerPrefix))
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid marker in %s: %s", fileName, trimmed)
+		}
+
+		noTransaction := len(fields) > 1 && fields[1] == optionNoTransaction
+		switch fields[0] {
+		case DirectionUp:
+			current = &up
+			migration.UpNoTransaction = noTransaction
+		case DirectionDown:
+			current = &down
+			migration.DownNoTransaction = noTransaction
+		case "StatementBegin", "StatementEnd":
+			// Sections are executed as a whole, so there is no need to delimit single statements
+		default:
+			return nil, fmt.Errorf("unknown marker in %s: %s", fileName, trimmed)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error while reading %s: %s", fileName, err)
+	}
+
+	migration.Up = strings.TrimSpace(up.String())
+	migration.Down = strings.TrimSpace(down.String())
+
+	if migration.Up == "" {
+		return nil, fmt.Errorf("migration %s has no up section", fileName)
+	}
+
+	return migration, nil
+}
+
+// parseFileName returns the version and the name of the migration stored inside the given file
+func parseFileName(fileName string) (int, string, error) {
+	base := strings.TrimSuffix(filepath.Base(fileName), ".sql")
+
+	prefix, name, found := strings.Cut(base, "-")
+	if !found || name == "" {
+		return 0, "", fmt.Errorf("invalid migration file name %s: must be in the NN-name.sql form", fileName)
+	}
+
+	version, err := strconv.Atoi(prefix)
+	if err != nil || version < 0 {
+		return 0, "", fmt.Errorf("invalid migration file name %s: version must be a positive number", fileName)
+	}
+
+	return version, name, nil
+}
+
+// LoadMigrations reads all the .sql files inside the root directory of the given file system,
+// returning them sorted by version
+func LoadMigrations(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("error while reading migrations directory: %s", err)
+	}
+
+	var migrations []*Migration
+	versions := map[int]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		bz, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("error while reading %s: %s", entry.Name(), err)
+		}
+
+		migration, err := ParseMigration(entry.Name(), bz)
+		if err != nil {
+			return nil, err
+		}
+
+		if other, ok := versions[migration.Version]; ok {
+			return nil, fmt.Errorf("duplicated migration version %d: %s and %s", migration.Version, other, entry.Name())
+		}
+		versions[migration.Version] = entry.Name()
+
+		migrations = append(migrations, migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,161 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/database/schema"
+)
+
+func TestParseMigration(t *testing.T) {
+	bz := []byte(`-- leading comment
+-- +migrate Up
+CREATE TABLE foo (id INT);
+CREATE INDEX foo_index ON foo (id);
+
+-- +migrate Down notransaction
+DROP TABLE foo;
+`)
+
+	migration, err := ParseMigration("07-foo.sql", bz)
+	require.NoError(t, err)
+	require.Equal(t, 7, migration.Version)
+	require.Equal(t, "foo", migration.Name)
+	require.Equal(t, "07-foo", migration.ID())
+	require.Equal(t, Checksum(bz), migration.Checksum)
+	require.Equal(t, "CREATE TABLE foo (id INT);\nCREATE INDEX foo_index ON foo (id);", migration.Up)
+	require.False(t, migration.UpNoTransaction)
+	require.Equal(t, "DROP TABLE foo;", migration.Down)
+	require.True(t, migration.DownNoTransaction)
+
+	_, err = ParseMigration("foo.sql", bz)
+	require.Error(t, err)
+
+	_, err = ParseMigration("08-foo.sql", []byte("-- +migrate Down\nDROP TABLE foo;"))
+	require.Error(t, err)
+
+	_, err = ParseMigration("08-foo.sql", []byte("-- +migrate Sideways\nDROP TABLE foo;"))
+	require.Error(t, err)
+}
+
+func TestLoadMigrations(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	write("10-second.sql", "-- +migrate Up\nSELECT 2;")
+	write("02-first.sql", "-- +migrate Up\nSELECT 1;")
+	write("README.md", "not a migration")
+
+	migrations, err := LoadMigrations(os.DirFS(dir))
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	require.Equal(t, "02-first", migrations[0].ID())
+	require.Equal(t, "10-second", migrations[1].ID())
+
+	write("2-duplicated.sql", "-- +migrate Up\nSELECT 1;")
+	_, err = LoadMigrations(os.DirFS(dir))
+	require.Error(t, err)
+}
+
+func TestLoadMigrations_Schema(t *testing.T) {
+	migrations, err := LoadMigrations(schema.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	require.Equal(t, 0, migrations[0].Version)
+}
+
+func buildMigrations(versions ...int) []*Migration {
+	var migrations []*Migration
+	for _, version := range versions {
+		migrations = append(migrations, &Migration{
+			Version:  version,
+			Name:     "migration",
+			Checksum: "checksum-" + strconv.Itoa(version),
+			Up:       "SELECT 1;",
+			Down:     "SELECT 0;",
+		})
+	}
+	return migrations
+}
+
+func buildRecords(migrations ...*Migration) []Record {
+	var records []Record
+	for _, migration := range migrations {
+		records = append(records, Record{
+			Kind:      KindSchema,
+			Version:   strconv.Itoa(migration.Version),
+			Name:      migration.Name,
+			Checksum:  migration.Checksum,
+			AppliedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+	return records
+}
+
+func TestBuildStatus(t *testing.T) {
+	migrations := buildMigrations(1, 2, 3)
+	records := buildRecords(migrations[0], migrations[1])
+	records[1].Checksum = "changed"
+	records = append(records, Record{Kind: KindSchema, Version: "9", Name: "removed"})
+
+	entries, err := BuildStatus(migrations, records)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	require.Equal(t, StatusApplied, entries[0].Status)
+	require.NotNil(t, entries[0].AppliedAt)
+	require.Equal(t, StatusModified, entries[1].Status)
+	require.Equal(t, StatusPending, entries[2].Status)
+	require.Nil(t, entries[2].AppliedAt)
+	require.Equal(t, StatusMissing, entries[3].Status)
+	require.Equal(t, 9, entries[3].Version)
+
+	require.Error(t, VerifyChecksums(migrations, records))
+	require.NoError(t, VerifyChecksums(migrations, buildRecords(migrations...)))
+}
+
+func TestPlanUp(t *testing.T) {
+	migrations := buildMigrations(1, 2, 3, 4)
+	records := buildRecords(migrations[0], migrations[2])
+
+	plan, err := PlanUp(migrations, records, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*Migration{migrations[1], migrations[3]}, plan)
+
+	plan, err = PlanUp(migrations, records, 3)
+	require.NoError(t, err)
+	require.Equal(t, []*Migration{migrations[1]}, plan)
+
+	plan, err = PlanBaseline(migrations, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Migration{migrations[0], migrations[1]}, plan)
+}
+
+func TestPlanDown(t *testing.T) {
+	migrations := buildMigrations(1, 2, 3)
+	records := buildRecords(migrations...)
+
+	plan, err := PlanDown(migrations, records, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*Migration{migrations[2], migrations[1]}, plan)
+
+	plan, err = PlanDown(migrations, records, 10)
+	require.NoError(t, err)
+	require.Len(t, plan, 3)
+
+	_, err = PlanDown(migrations, records, 0)
+	require.Error(t, err)
+
+	migrations[2].Down = ""
+	_, err = PlanDown(migrations, records, 1)
+	require.Error(t, err)
+
+	_, err = PlanDown(migrations[:2], records, 1)
+	require.Error(t, err)
+}
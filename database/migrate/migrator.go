@@ -0,0 +1,151 @@
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Migrator applies and reverts the SQL migrations, keeping track of them inside the database
+type Migrator struct {
+	store      *Store
+	migrations []*Migration
+	out        io.Writer
+}
+
+// NewMigrator returns a new Migrator instance that writes its progress to the given writer
+func NewMigrator(db *sqlx.DB, migrations []*Migration, out io.Writer) *Migrator {
+	return &Migrator{
+		store:      NewStore(db),
+		migrations: migrations,
+		out:        out,
+	}
+}
+
+// Store returns the store used to keep track of the applied migrations
+func (m *Migrator) Store() *Store {
+	return m.store
+}
+
+// Status returns the status of all the known migrations
+func (m *Migrator) Status() ([]Entry, error) {
+	records, err := m.store.Records(KindSchema)
+	if err != nil {
+		return nil, err
+	}
+	return BuildStatus(m.migrations, records)
+}
+
+// Plan returns the migrations that would be marked as applied because of the given baseline,
+// and the ones that would be applied in order to reach the given target version
+func (m *Migrator) Plan(target, baseline int) (marked []*Migration, pending []*Migration, err error) {
+	records, err := m.store.Records(KindSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = VerifyChecksums(m.migrations, records)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	marked, err = PlanBaseline(m.migrations, records, baseline)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Consider the baseline migrations as applied when computing the pending ones
+	for _, migration := range marked {
+		records = append(records, Record{
+			Kind:     KindSchema,
+			Version:  strconv.Itoa(migration.Version),
+			Name:     migration.Name,
+			Checksum: migration.Checksum,
+		})
+	}
+
+	pending, err = PlanUp(m.migrations, records, target)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return marked, pending, nil
+}
+
+// Up applies all the pending migrations up to the given target version (0 meaning all of them).
+// Migrations up to the baseline version are only marked as applied without being executed.
+// When dryRun is true, the statements are written to the output instead of being executed
+func (m *Migrator) Up(target, baseline int, dryRun bool) error {
+	marked, pending, err := m.Plan(target, baseline)
+	if err != nil {
+		return err
+	}
+
+	if !dryRun {
+		err = m.store.Init()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, migration := range marked {
+		fmt.Fprintf(m.out, "-- marking %s as applied\n", migration.ID())
+		if dryRun {
+			continue
+		}
+
+		err = m.store.SaveRecord(KindSchema, strconv.Itoa(migration.Version), migration.Name, migration.Checksum)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.run(pending, DirectionUp, dryRun)
+}
+
+// Down reverts the latest steps applied migrations.
+// When dryRun is true, the statements are written to the output instead of being executed
+func (m *Migrator) Down(steps int, dryRun bool) error {
+	records, err := m.store.Records(KindSchema)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyChecksums(m.migrations, records)
+	if err != nil {
+		return err
+	}
+
+	plan, err := PlanDown(m.migrations, records, steps)
+	if err != nil {
+		return err
+	}
+
+	return m.run(plan, DirectionDown, dryRun)
+}
+
+// run executes the given direction of all the provided migrations, in order
+func (m *Migrator) run(migrations []*Migration, direction string, dryRun bool) error {
+	if len(migrations) == 0 {
+		fmt.Fprintln(m.out, "-- nothing to do")
+		return nil
+	}
+
+	for _, migration := range migrations {
+		stmt, _ := migration.SQL(direction)
+		if dryRun {
+			fmt.Fprintf(m.out, "-- %s (%s)\n%s\n\n", migration.ID(), direction, stmt)
+			continue
+		}
+
+		fmt.Fprintf(m.out, "-- running %s (%s)\n", migration.ID(), direction)
+		err := m.store.Run(migration, direction)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
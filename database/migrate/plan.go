@@ -0,0 +1,179 @@
+package migrate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// StatusApplied tells that the migration has been applied and its file has not changed since then
+	StatusApplied = "applied"
+
+	// StatusPending tells that the migration has not been applied yet
+	StatusPending = "pending"
+
+	// StatusModified tells that the migration has been applied but its file has changed since then
+	StatusModified = "modified"
+
+	// StatusMissing tells that the migration has been applied but its file does not exist anymore
+	StatusMissing = "missing"
+)
+
+// Entry contains the status of a single schema migration
+type Entry struct {
+	Version   int
+	Name      string
+	Status    string
+	AppliedAt *time.Time
+}
+
+// BuildStatus compares the migrations on disk with the applied ones, returning the status of each of them
+func BuildStatus(migrations []*Migration, records []Record) ([]Entry, error) {
+	applied, err := recordsByVersion(records)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, migration := range migrations {
+		entry := Entry{Version: migration.Version, Name: migration.Name, Status: StatusPending}
+
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			entry.AppliedAt = &appliedAt
+			entry.Status = StatusApplied
+			if record.Checksum != "" && record.Checksum != migration.Checksum {
+				entry.Status = StatusModified
+			}
+			delete(applied, migration.Version)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	for version, record := range applied {
+		appliedAt := record.AppliedAt
+		entries = append(entries, Entry{
+			Version:   version,
+			Name:      record.Name,
+			Status:    StatusMissing,
+			AppliedAt: &appliedAt,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Version < entries[j].Version
+	})
+
+	return entries, nil
+}
+
+// VerifyChecksums returns an error if any applied migration has been modified after being applied
+func VerifyChecksums(migrations []*Migration, records []Record) error {
+	entries, err := BuildStatus(migrations, records)
+	if err != nil {
+		return err
+	}
+
+	var modified []string
+	for _, entry := range entries {
+		if entry.Status == StatusModified {
+			modified = append(modified, fmt.Sprintf("%02d-%s", entry.Version, entry.Name))
+		}
+	}
+
+	if len(modified) > 0 {
+		return fmt.Errorf("the following migrations have been modified after being applied: %s", strings.Join(modified, ", "))
+	}
+
+	return nil
+}
+
+// PlanUp returns the pending migrations having a version lower or equal to the given target, in the order
+// they should be applied. A target of 0 means that all the pending migrations should be applied
+func PlanUp(migrations []*Migration, records []Record, target int) ([]*Migration, error) {
+	applied, err := recordsByVersion(records)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan []*Migration
+	for _, migration := range migrations {
+		if target > 0 && migration.Version > target {
+			break
+		}
+
+		if _, ok := applied[migration.Version]; !ok {
+			plan = append(plan, migration)
+		}
+	}
+
+	return plan, nil
+}
+
+// PlanDown returns the latest steps applied migrations, in the order they should be reverted
+func PlanDown(migrations []*Migration, records []Record, steps int) ([]*Migration, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("the number of steps must be greater than zero")
+	}
+
+	byVersion := make(map[int]*Migration, len(migrations))
+	for _, migration := range migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	var versions []int
+	for _, record := range records {
+		version, err := record.SchemaVersion()
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %s", record.Version, err)
+		}
+		versions = append(versions, version)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	if steps > len(versions) {
+		steps = len(versions)
+	}
+
+	var plan []*Migration
+	for _, version := range versions[:steps] {
+		migration, ok := byVersion[version]
+		if !ok {
+			return nil, fmt.Errorf("cannot revert migration %d: its file does not exist", version)
+		}
+
+		if migration.Down == "" {
+			return nil, fmt.Errorf("cannot revert migration %s: it has no down section", migration.ID())
+		}
+
+		plan = append(plan, migration)
+	}
+
+	return plan, nil
+}
+
+// PlanBaseline returns the pending migrations having a version lower or equal to the given one.
+// These are the migrations that should be marked as applied on databases created without this tool
+func PlanBaseline(migrations []*Migration, records []Record, version int) ([]*Migration, error) {
+	if version <= 0 {
+		return nil, nil
+	}
+	return PlanUp(migrations, records, version)
+}
+
+// recordsByVersion indexes the given schema records by their numeric version
+func recordsByVersion(records []Record) (map[int]Record, error) {
+	applied := make(map[int]Record, len(records))
+	for _, record := range records {
+		version, err := strconv.Atoi(record.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %s", record.Version, err)
+		}
+		applied[version] = record
+	}
+	return applied, nil
+}
@@ -0,0 +1,168 @@
+package migrate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// KindSchema identifies the records of the SQL migrations
+	KindSchema = "schema"
+
+	// KindConfig identifies the config transforms, which are recorded inside the config file itself
+	KindConfig = "config"
+)
+
+// Record represents a row of the schema_migrations table
+type Record struct {
+	Kind      string    `db:"kind"`
+	Version   string    `db:"version"`
+	Name      string    `db:"name"`
+	Checksum  string    `db:"checksum"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+// SchemaVersion returns the numeric version of a schema migration record
+func (r Record) SchemaVersion() (int, error) {
+	return strconv.Atoi(r.Version)
+}
+
+// Store keeps track of the applied migrations inside the schema_migrations table
+type Store struct {
+	db *sqlx.DB
+}
+
+// NewStore returns a new Store instance
+func NewStore(db *sqlx.DB) *Store {
+	return &Store{db: db}
+}
+
+// Init creates the schema_migrations table if it does not exist yet
+func (s *Store) Init() error {
+	stmt := `
+CREATE TABLE IF NOT EXISTS schema_migrations
+(
+    kind       TEXT                        NOT NULL,
+    version    TEXT                        NOT NULL,
+    name       TEXT                        NOT NULL,
+    checksum   TEXT                        NOT NULL DEFAULT '',
+    applied_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (kind, version)
+)`
+	_, err := s.db.Exec(stmt)
+	if err != nil {
+		return fmt.Errorf("error while creating schema_migrations table: %s", err)
+	}
+	return nil
+}
+
+// exists tells whether the schema_migrations table has been created already
+func (s *Store) exists() (bool, error) {
+	var exists bool
+	err := s.db.QueryRow(`SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("error while checking schema_migrations table: %s", err)
+	}
+	return exists, nil
+}
+
+// Records returns the applied migrations of the given kind.
+// If the schema_migrations table does not exist yet, no migration is considered as applied
+func (s *Store) Records(kind string) ([]Record, error) {
+	exists, err := s.exists()
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	var records []Record
+	err = s.db.Select(&records, `SELECT * FROM schema_migrations WHERE kind = $1`, kind)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting applied migrations: %s", err)
+	}
+
+	if kind == KindSchema {
+		sort.Slice(records, func(i, j int) bool {
+			vi, _ := records[i].SchemaVersion()
+			vj, _ := records[j].SchemaVersion()
+			return vi < vj
+		})
+	}
+
+	return records, nil
+}
+
+// insertRecordQuery stores a new applied migration
+const insertRecordQuery = `
+INSERT INTO schema_migrations (kind, version, name, checksum, applied_at)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (kind, version) DO UPDATE
+    SET name = excluded.name,
+        checksum = excluded.checksum,
+        applied_at = excluded.applied_at`
+
+// deleteRecordQuery removes a reverted migration
+const deleteRecordQuery = `DELETE FROM schema_migrations WHERE kind = $1 AND version = $2`
+
+// SaveRecord stores the given migration as applied
+func (s *Store) SaveRecord(kind, version, name, checksum string) error {
+	_, err := s.db.Exec(insertRecordQuery, kind, version, name, checksum)
+	if err != nil {
+		return fmt.Errorf("error while storing migration %s: %s", version, err)
+	}
+	return nil
+}
+
+// Run executes the given statements of a migration and updates its record accordingly.
+// Unless noTransaction is true, both operations are performed inside the same transaction
+func (s *Store) Run(migration *Migration, direction string) error {
+	stmt, noTransaction := migration.SQL(direction)
+	version := strconv.Itoa(migration.Version)
+
+	if noTransaction {
+		_, err := s.db.Exec(stmt)
+		if err != nil {
+			return fmt.Errorf("error while running migration %s: %s", migration.ID(), err)
+		}
+
+		if direction == DirectionDown {
+			_, err = s.db.Exec(deleteRecordQuery, KindSchema, version)
+		} else {
+			_, err = s.db.Exec(insertRecordQuery, KindSchema, version, migration.Name, migration.Checksum)
+		}
+		if err != nil {
+			return fmt.Errorf("error while updating migration %s record: %s", migration.ID(), err)
+		}
+		return nil
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("error while starting transaction: %s", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	_, err = tx.Exec(stmt)
+	if err != nil {
+		return fmt.Errorf("error while running migration %s: %s", migration.ID(), err)
+	}
+
+	if direction == DirectionDown {
+		_, err = tx.Exec(deleteRecordQuery, KindSchema, version)
+	} else {
+		_, err = tx.Exec(insertRecordQuery, KindSchema, version, migration.Name, migration.Checksum)
+	}
+	if err != nil {
+		return fmt.Errorf("error while updating migration %s record: %s", migration.ID(), err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("error while committing migration %s: %s", migration.ID(), err)
+	}
+
+	return nil
+}
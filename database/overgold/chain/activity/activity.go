@@ -0,0 +1,23 @@
+package activity
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain"
+)
+
+var _ chain.Activity = &Repository{}
+
+type (
+	// Repository - defines a repository for address activity repository
+	Repository struct {
+		db *sqlx.DB
+	}
+)
+
+// NewRepository constructor.
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
@@ -0,0 +1,81 @@
+package activity
+
+import (
+	"fmt"
+	"strings"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/lib/pq"
+
+	db "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// InsertAddressActivities - insert new activities in a database (overgold_address_activity).
+func (r Repository) InsertAddressActivities(activities ...types.AddressActivity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	q := `
+		INSERT INTO overgold_address_activity (
+			tx_hash, msg_index, height, address, role, module, msg_type, timestamp
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		) ON CONFLICT (tx_hash, msg_index, address, role) DO NOTHING
+	`
+
+	for _, activity := range activities {
+		m := toAddressActivityDatabase(activity)
+
+		if _, err := r.db.Exec(q,
+			m.TxHash, m.MsgIndex, m.Height, m.Address, m.Role, m.Module, m.MsgType, m.Timestamp,
+		); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+// GetAddressActivities - method that get the activities of an address, the newest first,
+// along with the total number of activities matching the filter (overgold_address_activity).
+func (r Repository) GetAddressActivities(filter types.AddressActivityFilter) ([]types.AddressActivity, uint64, error) {
+	conditions := []string{"address = $1"}
+	args := []interface{}{filter.Address}
+
+	if len(filter.MsgTypes) != 0 {
+		args = append(args, pq.Array(filter.MsgTypes))
+		conditions = append(conditions, fmt.Sprintf("msg_type = ANY($%d)", len(args)))
+	}
+
+	if len(filter.Roles) != 0 {
+		args = append(args, pq.Array(filter.Roles))
+		conditions = append(conditions, fmt.Sprintf("role = ANY($%d)", len(args)))
+	}
+
+	where := strings.Join(conditions, " AND ")
+
+	var total uint64
+	if err := r.db.Get(&total, `SELECT COUNT(*) FROM overgold_address_activity WHERE `+where, args...); err != nil {
+		return nil, 0, errs.Internal{Cause: err.Error()}
+	}
+	if total == 0 {
+		return nil, 0, errs.NotFound{What: tableAddressActivity}
+	}
+
+	q := fmt.Sprintf(`
+		SELECT id, tx_hash, msg_index, height, address, role, module, msg_type, timestamp
+		FROM overgold_address_activity
+		WHERE %s
+		ORDER BY height DESC, msg_index DESC, id DESC
+		OFFSET $%d LIMIT $%d
+	`, where, len(args)+1, len(args)+2)
+
+	var result []db.AddressActivity
+	if err := r.db.Select(&result, q, append(args, filter.Offset, filter.Limit)...); err != nil {
+		return nil, 0, errs.Internal{Cause: err.Error()}
+	}
+
+	return toAddressActivityDomainList(result), total, nil
+}
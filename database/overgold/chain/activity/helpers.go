@@ -0,0 +1,5 @@
+package activity
+
+const (
+	tableAddressActivity = "overgold_address_activity"
+)
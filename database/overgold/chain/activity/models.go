@@ -0,0 +1,35 @@
+package activity
+
+import (
+	db "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// toAddressActivityDomain - mapping func to a domain model.
+func toAddressActivityDomain(m db.AddressActivity) types.AddressActivity {
+	return types.NewAddressActivity(m.TxHash, m.MsgIndex, m.Height, m.Address, m.Role, m.Module, m.MsgType, m.Timestamp)
+}
+
+// toAddressActivityDomainList - mapping func to a domain list.
+func toAddressActivityDomainList(m []db.AddressActivity) []types.AddressActivity {
+	res := make([]types.AddressActivity, 0, len(m))
+	for _, activity := range m {
+		res = append(res, toAddressActivityDomain(activity))
+	}
+
+	return res
+}
+
+// toAddressActivityDatabase - mapping func to a database model.
+func toAddressActivityDatabase(m types.AddressActivity) db.AddressActivity {
+	return db.AddressActivity{
+		TxHash:    m.TxHash,
+		MsgIndex:  m.MsgIndex,
+		Height:    m.Height,
+		Address:   m.Address,
+		Role:      m.Role,
+		Module:    m.Module,
+		MsgType:   m.MsgType,
+		Timestamp: m.Timestamp,
+	}
+}
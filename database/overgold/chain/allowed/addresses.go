@@ -31,6 +31,19 @@ func (r Repository) GetAllAddresses(filter filter.Filter) ([]allowed.Addresses,
 	return toAddressesDomainList(result), nil
 }
 
+// IsAllowedAddress - method that tells whether the given address is contained inside any of the allowed
+// addresses (overgold_allowed_addresses).
+func (r Repository) IsAllowedAddress(address string) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM overgold_allowed_addresses WHERE $1 = ANY(address))`
+
+	var allowed bool
+	if err := r.db.Get(&allowed, q, address); err != nil {
+		return false, errs.Internal{Cause: err.Error()}
+	}
+
+	return allowed, nil
+}
+
 // InsertToAddresses - insert a new Addresses in a database (overgold_allowed_addresses).
 func (r Repository) InsertToAddresses(addresses ...allowed.Addresses) error {
 	if len(addresses) == 0 {
@@ -113,3 +126,32 @@ func (r Repository) DeleteAddressesByID(ids ...uint64) error {
 
 	return nil
 }
+
+// ReplaceAddresses - method that replaces all the data in a database with the given one (overgold_allowed_addresses).
+func (r Repository) ReplaceAddresses(addresses ...allowed.Addresses) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	if _, err = tx.Exec(`DELETE FROM overgold_allowed_addresses`); err != nil {
+		_ = tx.Rollback()
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	q := `INSERT INTO overgold_allowed_addresses (creator, address) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	for _, a := range addresses {
+		m := toAddressesDatabase(a)
+		if _, err = tx.Exec(q, m.Creator, m.Address); err != nil {
+			_ = tx.Rollback()
+			return errs.Internal{Cause: err.Error()}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
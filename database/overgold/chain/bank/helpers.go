@@ -20,6 +20,7 @@ type (
 		FromAddress string         `db:"from_address"`
 		ToAddress   string         `db:"to_address"`
 		Amount      pq.StringArray `db:"amount"`
+		Fee         pq.StringArray `db:"fee"`
 	}
 
 	// msgSend represents a single row inside the 'msg_multi_send' table (used only for SELECT)
@@ -6,10 +6,12 @@ import (
 
 	"git.ooo.ua/vipcoin/lib/errs"
 	"git.ooo.ua/vipcoin/lib/filter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/lib/pq"
 
 	"github.com/forbole/bdjuno/v4/database/overgold/chain"
+	db "github.com/forbole/bdjuno/v4/database/types"
 )
 
 // GetAllMsgSend - method that get data from a db (msg_send).
@@ -58,3 +60,15 @@ func (r Repository) InsertMsgSend(hash string, msgs ...bank.MsgSend) error {
 
 	return nil
 }
+
+// UpdateMsgSendFee - method that stores the fee actually charged for the MsgSend of the given tx (msg_send).
+func (r Repository) UpdateMsgSendFee(hash string, fee sdk.Coins) error {
+	q := `UPDATE msg_send SET fee = $2 WHERE tx_hash = $1`
+
+	// NOTE: use pq.Array for custom type COIN[]
+	if _, err := r.db.Exec(q, hash, pq.Array(db.NewDbCoins(fee))); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"git.ooo.ua/vipcoin/lib/errs"
 	"git.ooo.ua/vipcoin/lib/filter"
 	core "git.ooo.ua/vipcoin/ovg-chain/x/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lib/pq"
 
 	"github.com/forbole/bdjuno/v4/database/overgold/chain"
 	db "github.com/forbole/bdjuno/v4/database/types"
@@ -62,3 +64,15 @@ func (r Repository) InsertMsgSend(hash string, msgs ...core.MsgSend) error {
 
 	return nil
 }
+
+// UpdateMsgSendFee - method that stores the fee actually charged for the MsgSend of the given tx (overgold_core_send).
+func (r Repository) UpdateMsgSendFee(hash string, fee sdk.Coins) error {
+	q := `UPDATE overgold_core_send SET fee = $2 WHERE tx_hash = $1`
+
+	// NOTE: use pq.Array for custom type COIN[]
+	if _, err := r.db.Exec(q, hash, pq.Array(db.NewDbCoins(fee))); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
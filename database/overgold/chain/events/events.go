@@ -0,0 +1,23 @@
+package events
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain"
+)
+
+var _ chain.Events = &Repository{}
+
+type (
+	// Repository - defines a repository for events repository
+	Repository struct {
+		db *sqlx.DB
+	}
+)
+
+// NewRepository constructor.
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
@@ -0,0 +1,60 @@
+package events
+
+import (
+	"database/sql"
+	"errors"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	"github.com/lib/pq"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain"
+	db "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// GetAllFeeChargedEvents - method that get data from a db (overgold_event_fee_charged).
+func (r Repository) GetAllFeeChargedEvents(filter filter.Filter) ([]types.FeeChargedEvent, error) {
+	query, args := filter.Build(tableFeeCharged)
+
+	var result []db.EventFeeCharged
+	if err := r.db.Select(&result, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NotFound{What: tableFeeCharged}
+		}
+
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableFeeCharged}
+	}
+
+	return toFeeChargedDomainList(result)
+}
+
+// InsertFeeChargedEvents - insert a new FeeChargedEvent in a database (overgold_event_fee_charged).
+func (r Repository) InsertFeeChargedEvents(events ...types.FeeChargedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	q := `
+		INSERT INTO overgold_event_fee_charged (
+			tx_hash, msg_index, event_index, payer, amount
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+	`
+
+	// NOTE: use pq.Array for custom type COIN[]
+	for _, e := range events {
+		if _, err := r.db.Exec(q, e.TxHash, e.MsgIndex, e.EventIndex, e.Payer, pq.Array(db.NewDbCoins(e.Amount))); err != nil {
+			if chain.IsAlreadyExists(err) {
+				continue
+			}
+			return errs.Internal{Cause: err.Error()}
+		}
+	}
+
+	return nil
+}
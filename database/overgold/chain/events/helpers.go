@@ -0,0 +1,7 @@
+package events
+
+const (
+	tableTransfer   = "overgold_event_transfer"
+	tableFeeCharged = "overgold_event_fee_charged"
+	tableRewardPaid = "overgold_event_reward_paid"
+)
@@ -0,0 +1,81 @@
+package events
+
+import (
+	db "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// toTransferDomain - mapping func to a domain model.
+func toTransferDomain(e db.EventTransfer) (types.TransferEvent, error) {
+	amount, err := db.FromPqStringArrayToCoins(e.Amount)
+	if err != nil {
+		return types.TransferEvent{}, err
+	}
+
+	return types.NewTransferEvent(e.TxHash, e.MsgIndex, e.EventIndex, e.Sender, e.Recipient, amount), nil
+}
+
+// toTransferDomainList - mapping func to a domain list.
+func toTransferDomainList(e []db.EventTransfer) ([]types.TransferEvent, error) {
+	res := make([]types.TransferEvent, 0, len(e))
+	for _, event := range e {
+		r, err := toTransferDomain(event)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// toFeeChargedDomain - mapping func to a domain model.
+func toFeeChargedDomain(e db.EventFeeCharged) (types.FeeChargedEvent, error) {
+	amount, err := db.FromPqStringArrayToCoins(e.Amount)
+	if err != nil {
+		return types.FeeChargedEvent{}, err
+	}
+
+	return types.NewFeeChargedEvent(e.TxHash, e.MsgIndex, e.EventIndex, e.Payer, amount), nil
+}
+
+// toFeeChargedDomainList - mapping func to a domain list.
+func toFeeChargedDomainList(e []db.EventFeeCharged) ([]types.FeeChargedEvent, error) {
+	res := make([]types.FeeChargedEvent, 0, len(e))
+	for _, event := range e {
+		r, err := toFeeChargedDomain(event)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// toRewardPaidDomain - mapping func to a domain model.
+func toRewardPaidDomain(e db.EventRewardPaid) (types.RewardPaidEvent, error) {
+	amount, err := db.FromPqStringArrayToCoins(e.Amount)
+	if err != nil {
+		return types.RewardPaidEvent{}, err
+	}
+
+	return types.NewRewardPaidEvent(e.TxHash, e.MsgIndex, e.EventIndex, e.Recipient, amount), nil
+}
+
+// toRewardPaidDomainList - mapping func to a domain list.
+func toRewardPaidDomainList(e []db.EventRewardPaid) ([]types.RewardPaidEvent, error) {
+	res := make([]types.RewardPaidEvent, 0, len(e))
+	for _, event := range e {
+		r, err := toRewardPaidDomain(event)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package events
+
+import (
+	"database/sql"
+	"errors"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	"github.com/lib/pq"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain"
+	db "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// GetAllRewardPaidEvents - method that get data from a db (overgold_event_reward_paid).
+func (r Repository) GetAllRewardPaidEvents(filter filter.Filter) ([]types.RewardPaidEvent, error) {
+	query, args := filter.Build(tableRewardPaid)
+
+	var result []db.EventRewardPaid
+	if err := r.db.Select(&result, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NotFound{What: tableRewardPaid}
+		}
+
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableRewardPaid}
+	}
+
+	return toRewardPaidDomainList(result)
+}
+
+// InsertRewardPaidEvents - insert a new RewardPaidEvent in a database (overgold_event_reward_paid).
+func (r Repository) InsertRewardPaidEvents(events ...types.RewardPaidEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	q := `
+		INSERT INTO overgold_event_reward_paid (
+			tx_hash, msg_index, event_index, recipient, amount
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+	`
+
+	// NOTE: use pq.Array for custom type COIN[]
+	for _, e := range events {
+		if _, err := r.db.Exec(q, e.TxHash, e.MsgIndex, e.EventIndex, e.Recipient, pq.Array(db.NewDbCoins(e.Amount))); err != nil {
+			if chain.IsAlreadyExists(err) {
+				continue
+			}
+			return errs.Internal{Cause: err.Error()}
+		}
+	}
+
+	return nil
+}
@@ -40,6 +40,15 @@ const (
 	layoutDate = "2006-01-02"
 )
 
+// ext returns the given transaction, or the database itself when no transaction is given.
+func (r Repository) ext(tx *sqlx.Tx) sqlx.Ext {
+	if tx != nil {
+		return tx
+	}
+
+	return r.db
+}
+
 func commit(tx *sqlx.Tx, err error) {
 	if err != nil {
 		_ = tx.Rollback()
@@ -32,7 +32,7 @@ func (r Repository) GetAllM2MTariffFees(filter filter.Filter) ([]types.FeeExclud
 }
 
 // InsertToM2MTariffFees - insert new data in a database (overgold_feeexcluder_m2m_tariff_fees).
-func (r Repository) InsertToM2MTariffFees(_ *sqlx.Tx, ids ...types.FeeExcluderM2MTariffFees) (err error) {
+func (r Repository) InsertToM2MTariffFees(tx *sqlx.Tx, ids ...types.FeeExcluderM2MTariffFees) (err error) {
 	q := `
 		INSERT INTO overgold_feeexcluder_m2m_tariff_fees (
 			tariff_id, fees_id
@@ -43,7 +43,7 @@ func (r Repository) InsertToM2MTariffFees(_ *sqlx.Tx, ids ...types.FeeExcluderM2
 	`
 
 	for _, m := range ids {
-		if _, err = r.db.Exec(q, m.TariffID, m.FeesID); err != nil {
+		if _, err = r.ext(tx).Exec(q, m.TariffID, m.FeesID); err != nil {
 			if chain.IsAlreadyExists(err) {
 				continue
 			}
@@ -55,10 +55,10 @@ func (r Repository) InsertToM2MTariffFees(_ *sqlx.Tx, ids ...types.FeeExcluderM2
 }
 
 // DeleteM2MTariffFeesByTariff - method that deletes data in a database (overgold_feeexcluder_m2m_tariff_fees).
-func (r Repository) DeleteM2MTariffFeesByTariff(_ *sqlx.Tx, tariffID uint64) (err error) {
+func (r Repository) DeleteM2MTariffFeesByTariff(tx *sqlx.Tx, tariffID uint64) (err error) {
 	q := `DELETE FROM overgold_feeexcluder_m2m_tariff_fees WHERE tariff_id IN ($1)`
 
-	if _, err = r.db.Exec(q, tariffID); err != nil {
+	if _, err = r.ext(tx).Exec(q, tariffID); err != nil {
 		return errs.Internal{Cause: err.Error()}
 	}
 
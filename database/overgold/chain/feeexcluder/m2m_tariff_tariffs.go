@@ -32,7 +32,7 @@ func (r Repository) GetAllM2MTariffTariffs(filter filter.Filter) ([]types.FeeExc
 }
 
 // InsertToM2MTariffTariffs - insert new data in a database (overgold_feeexcluder_m2m_tariff_tariffs).
-func (r Repository) InsertToM2MTariffTariffs(_ *sqlx.Tx, ids ...types.FeeExcluderM2MTariffTariffs) (err error) {
+func (r Repository) InsertToM2MTariffTariffs(tx *sqlx.Tx, ids ...types.FeeExcluderM2MTariffTariffs) (err error) {
 	if len(ids) == 0 {
 		return nil
 	}
@@ -47,7 +47,7 @@ func (r Repository) InsertToM2MTariffTariffs(_ *sqlx.Tx, ids ...types.FeeExclude
 	`
 
 	for _, m := range ids {
-		if _, err = r.db.Exec(q, m.TariffID, m.TariffsID); err != nil {
+		if _, err = r.ext(tx).Exec(q, m.TariffID, m.TariffsID); err != nil {
 			if chain.IsAlreadyExists(err) {
 				continue
 			}
@@ -62,7 +62,7 @@ func (r Repository) InsertToM2MTariffTariffs(_ *sqlx.Tx, ids ...types.FeeExclude
 func (r Repository) DeleteM2MTariffTariffsByTariffs(tx *sqlx.Tx, id uint64) (err error) {
 	q := `DELETE FROM overgold_feeexcluder_m2m_tariff_tariffs WHERE tariffs_id IN ($1)`
 
-	if _, err = r.db.Exec(q, id); err != nil {
+	if _, err = r.ext(tx).Exec(q, id); err != nil {
 		return errs.Internal{Cause: err.Error()}
 	}
 
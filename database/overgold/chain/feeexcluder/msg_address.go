@@ -79,3 +79,32 @@ func (r Repository) DeleteAddress(_ *sqlx.Tx, id uint64) (err error) {
 
 	return nil
 }
+
+// ReplaceAddresses - method that replaces all the data in a database with the given one (overgold_feeexcluder_address).
+func (r Repository) ReplaceAddresses(addresses ...fe.Address) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	if _, err = tx.Exec(`DELETE FROM overgold_feeexcluder_address`); err != nil {
+		_ = tx.Rollback()
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	q := `INSERT INTO overgold_feeexcluder_address (msg_id, creator, address) VALUES ($1, $2, $3)`
+
+	for _, a := range addresses {
+		m := toAddressDatabase(0, a)
+		if _, err = tx.Exec(q, m.MsgID, m.Creator, m.Address); err != nil {
+			_ = tx.Rollback()
+			return errs.Internal{Cause: err.Error()}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
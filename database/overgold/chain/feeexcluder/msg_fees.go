@@ -34,7 +34,7 @@ func (r Repository) GetAllFees(filter filter.Filter) ([]*fe.Fees, error) {
 }
 
 // InsertToFees - insert new data in a database (overgold_feeexcluder_fees).
-func (r Repository) InsertToFees(_ *sqlx.Tx, fees *fe.Fees) (lastID uint64, err error) {
+func (r Repository) InsertToFees(tx *sqlx.Tx, fees *fe.Fees) (lastID uint64, err error) {
 	q := `
 		INSERT INTO overgold_feeexcluder_fees (
 			msg_id, creator, amount_from, fee, ref_reward, stake_reward, min_amount, no_ref_reward
@@ -48,7 +48,7 @@ func (r Repository) InsertToFees(_ *sqlx.Tx, fees *fe.Fees) (lastID uint64, err
 		return 0, errs.Internal{Cause: err.Error()}
 	}
 
-	if err = r.db.QueryRowx(q,
+	if err = r.ext(tx).QueryRowx(q,
 		m.MsgID,
 		m.Creator,
 		m.AmountFrom,
@@ -103,10 +103,10 @@ func (r Repository) UpdateFees(_ *sqlx.Tx, id uint64, fees *fe.Fees) (err error)
 }
 
 // DeleteFees - method that deletes data in a database (overgold_feeexcluder_fees).
-func (r Repository) DeleteFees(_ *sqlx.Tx, id uint64) (err error) {
+func (r Repository) DeleteFees(tx *sqlx.Tx, id uint64) (err error) {
 	q := `DELETE FROM overgold_feeexcluder_fees WHERE id IN ($1)`
 
-	if _, err = r.db.Exec(q, id); err != nil {
+	if _, err = r.ext(tx).Exec(q, id); err != nil {
 		return errs.Internal{Cause: err.Error()}
 	}
 
@@ -64,7 +64,7 @@ func (r Repository) GetAllTariff(f filter.Filter) ([]*fe.Tariff, error) {
 }
 
 // InsertToTariff - insert new data in a database (overgold_feeexcluder_tariff).
-func (r Repository) InsertToTariff(_ *sqlx.Tx, tariff *fe.Tariff) (lastID uint64, err error) {
+func (r Repository) InsertToTariff(tx *sqlx.Tx, tariff *fe.Tariff) (lastID uint64, err error) {
 	// 1) add tariff
 	q := `
 		INSERT INTO overgold_feeexcluder_tariff (
@@ -79,7 +79,7 @@ func (r Repository) InsertToTariff(_ *sqlx.Tx, tariff *fe.Tariff) (lastID uint64
 		return 0, errs.Internal{Cause: err.Error()}
 	}
 
-	if err = r.db.QueryRowx(q, m.MsgID, m.Amount, m.Denom, m.MinRefBalance).Scan(&lastID); err != nil {
+	if err = r.ext(tx).QueryRowx(q, m.MsgID, m.Amount, m.Denom, m.MinRefBalance).Scan(&lastID); err != nil {
 		if chain.IsAlreadyExists(err) {
 			return 0, nil
 		}
@@ -89,7 +89,7 @@ func (r Repository) InsertToTariff(_ *sqlx.Tx, tariff *fe.Tariff) (lastID uint64
 	// 2) add fees and save unique ids
 	feesIDs := make([]uint64, 0, len(tariff.Fees))
 	for _, f := range tariff.Fees {
-		id, err := r.InsertToFees(nil, f)
+		id, err := r.InsertToFees(tx, f)
 		if err != nil {
 			return 0, err
 		}
@@ -110,7 +110,7 @@ func (r Repository) InsertToTariff(_ *sqlx.Tx, tariff *fe.Tariff) (lastID uint64
 		})
 	}
 
-	return lastID, r.InsertToM2MTariffFees(nil, m2m...)
+	return lastID, r.InsertToM2MTariffFees(tx, m2m...)
 }
 
 // UpdateTariff - method that updates in a database (overgold_feeexcluder_tariff).
@@ -163,7 +163,7 @@ func (r Repository) UpdateTariff(_ *sqlx.Tx, id uint64, tariff *fe.Tariff) (err
 }
 
 // DeleteTariff - method that deletes data in a database (overgold_feeexcluder_tariff).
-func (r Repository) DeleteTariff(_ *sqlx.Tx, id uint64) (err error) {
+func (r Repository) DeleteTariff(tx *sqlx.Tx, id uint64) (err error) {
 	// 1) delete many-to-many tariff fees and get ids
 	m2m, err := r.GetAllM2MTariffFees(filter.NewFilter().SetArgument(types.FieldTariffID, id))
 	if err != nil {
@@ -172,13 +172,13 @@ func (r Repository) DeleteTariff(_ *sqlx.Tx, id uint64) (err error) {
 		}
 	}
 
-	if err = r.DeleteM2MTariffFeesByTariff(nil, id); err != nil {
+	if err = r.DeleteM2MTariffFeesByTariff(tx, id); err != nil {
 		return err
 	}
 
 	// 2) delete fees
 	for _, m := range m2m {
-		if err = r.DeleteFees(nil, m.FeesID); err != nil {
+		if err = r.DeleteFees(tx, m.FeesID); err != nil {
 			return err
 		}
 	}
@@ -186,7 +186,7 @@ func (r Repository) DeleteTariff(_ *sqlx.Tx, id uint64) (err error) {
 	// 3) delete tariff
 	q := `DELETE FROM overgold_feeexcluder_tariff WHERE id IN ($1)`
 
-	if _, err = r.db.Exec(q, id); err != nil {
+	if _, err = r.ext(tx).Exec(q, id); err != nil {
 		return errs.Internal{Cause: err.Error()}
 	}
 
@@ -93,7 +93,7 @@ func (r Repository) GetAllTariffsDB(f filter.Filter) ([]types.FeeExcluderTariffs
 }
 
 // InsertToTariffs - insert new data in a database (overgold_feeexcluder_tariffs).
-func (r Repository) InsertToTariffs(_ *sqlx.Tx, tariffs fe.Tariffs) (lastID uint64, err error) {
+func (r Repository) InsertToTariffs(tx *sqlx.Tx, tariffs fe.Tariffs) (lastID uint64, err error) {
 	// 1) add tariffs
 	q := `
 		INSERT INTO overgold_feeexcluder_tariffs (
@@ -104,7 +104,7 @@ func (r Repository) InsertToTariffs(_ *sqlx.Tx, tariffs fe.Tariffs) (lastID uint
 	`
 
 	m := toTariffsDatabase(0, tariffs)
-	if err = r.db.QueryRowx(q, m.Denom, m.Creator).Scan(&lastID); err != nil {
+	if err = r.ext(tx).QueryRowx(q, m.Denom, m.Creator).Scan(&lastID); err != nil {
 		if chain.IsAlreadyExists(err) {
 			return 0, nil
 		}
@@ -114,7 +114,7 @@ func (r Repository) InsertToTariffs(_ *sqlx.Tx, tariffs fe.Tariffs) (lastID uint
 	// 2) add tariff and save unique ids
 	tariffIDs := make([]uint64, 0, len(tariffs.Tariffs))
 	for _, t := range tariffs.Tariffs {
-		id, err := r.InsertToTariff(nil, t)
+		id, err := r.InsertToTariff(tx, t)
 		if err != nil {
 			return 0, err
 		}
@@ -131,7 +131,7 @@ func (r Repository) InsertToTariffs(_ *sqlx.Tx, tariffs fe.Tariffs) (lastID uint
 		})
 	}
 
-	return lastID, r.InsertToM2MTariffTariffs(nil, m2m...)
+	return lastID, r.InsertToM2MTariffTariffs(tx, m2m...)
 }
 
 // UpdateTariffs - method that updates in a database (overgold_feeexcluder_tariffs).
@@ -175,7 +175,7 @@ func (r Repository) UpdateTariffs(_ *sqlx.Tx, id uint64, tariffs fe.Tariffs) (er
 }
 
 // DeleteTariffs - method that deletes data in a database (overgold_feeexcluder_tariffs).
-func (r Repository) DeleteTariffs(_ *sqlx.Tx, id uint64) (err error) {
+func (r Repository) DeleteTariffs(tx *sqlx.Tx, id uint64) (err error) {
 
 	// 1) delete many-to-many tariff tariffs and get ids
 	m2m, err := r.GetAllM2MTariffTariffs(filter.NewFilter().SetArgument(types.FieldTariffsID, id))
@@ -185,13 +185,13 @@ func (r Repository) DeleteTariffs(_ *sqlx.Tx, id uint64) (err error) {
 		}
 	}
 
-	if err = r.DeleteM2MTariffTariffsByTariffs(nil, id); err != nil {
+	if err = r.DeleteM2MTariffTariffsByTariffs(tx, id); err != nil {
 		return err
 	}
 
 	// 2) delete tariff
 	for _, m := range m2m {
-		if err = r.DeleteTariff(nil, m.TariffID); err != nil {
+		if err = r.DeleteTariff(tx, m.TariffID); err != nil {
 			return err
 		}
 	}
@@ -199,7 +199,41 @@ func (r Repository) DeleteTariffs(_ *sqlx.Tx, id uint64) (err error) {
 	// 3) delete tariffs
 	q := `DELETE FROM overgold_feeexcluder_tariffs WHERE id IN ($1)`
 
-	if _, err = r.db.Exec(q, id); err != nil {
+	if _, err = r.ext(tx).Exec(q, id); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
+
+// ReplaceTariffs - method that replaces the tariffs of the given denom in a single transaction
+// (overgold_feeexcluder_tariffs). When tariffs is nil, the stored ones are only deleted.
+func (r Repository) ReplaceTariffs(denom string, tariffs *fe.Tariffs) error {
+	stored, err := r.GetAllTariffsDB(filter.NewFilter().SetArgument(types.FieldDenom, denom))
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return err
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	for _, t := range stored {
+		if err = r.DeleteTariffs(tx, t.ID); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if tariffs != nil {
+		if _, err = r.InsertToTariffs(tx, *tariffs); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
 		return errs.Internal{Cause: err.Error()}
 	}
 
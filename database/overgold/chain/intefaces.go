@@ -1,16 +1,20 @@
 package chain
 
 import (
+	"time"
+
 	"git.ooo.ua/vipcoin/lib/filter"
 	allowed "git.ooo.ua/vipcoin/ovg-chain/x/allowed/types"
 	core "git.ooo.ua/vipcoin/ovg-chain/x/core/types"
 	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
 	referral "git.ooo.ua/vipcoin/ovg-chain/x/referral/types"
 	stake "git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
 // custom ovg types
@@ -20,8 +24,10 @@ type (
 		DeleteAddressesByAddress(addresses ...string) error
 		DeleteAddressesByID(ids ...uint64) error
 		GetAllAddresses(filter filter.Filter) ([]allowed.Addresses, error)
+		IsAllowedAddress(address string) (bool, error)
 		InsertToAddresses(addresses ...allowed.Addresses) error
 		UpdateAddresses(addresses ...allowed.Addresses) error
+		ReplaceAddresses(addresses ...allowed.Addresses) error
 
 		GetAllCreateAddresses(filter filter.Filter) ([]allowed.MsgCreateAddresses, error)
 		InsertToCreateAddresses(hash string, msgs ...*allowed.MsgCreateAddresses) error
@@ -46,6 +52,7 @@ type (
 
 		GetAllMsgSend(filter filter.Filter) ([]core.MsgSend, error)
 		InsertMsgSend(hash string, msgs ...core.MsgSend) error
+		UpdateMsgSendFee(hash string, fee sdk.Coins) error
 	}
 
 	// FeeExcluder - describes an interface for working with database models.
@@ -91,6 +98,7 @@ type (
 		GetAllAddress(filter filter.Filter) ([]fe.Address, error)
 		InsertToAddress(tx *sqlx.Tx, addresses fe.Address) (uint64, error)
 		UpdateAddress(tx *sqlx.Tx, id uint64, address fe.Address) error
+		ReplaceAddresses(addresses ...fe.Address) error
 
 		DeleteFees(tx *sqlx.Tx, id uint64) error
 		GetAllFees(filter filter.Filter) ([]*fe.Fees, error)
@@ -118,6 +126,7 @@ type (
 		GetAllTariffsDB(f filter.Filter) ([]types.FeeExcluderTariffs, error)
 		InsertToTariffs(tx *sqlx.Tx, tariffs fe.Tariffs) (uint64, error)
 		UpdateTariffs(tx *sqlx.Tx, id uint64, tariffs fe.Tariffs) error
+		ReplaceTariffs(denom string, tariffs *fe.Tariffs) error
 	}
 
 	FeeExcluderM2MTables interface {
@@ -150,6 +159,11 @@ type (
 	Referral interface {
 		GetAllMsgSetReferrer(filter filter.Filter) ([]referral.MsgSetReferrer, error)
 		InsertMsgSetReferrer(hash string, msgs ...referral.MsgSetReferrer) error
+
+		GetReferralLink(address string) (bdtypes.ReferralLink, error)
+		GetReferralLinks() ([]bdtypes.ReferralLink, error)
+		SaveReferralLinks(links ...bdtypes.ReferralLink) error
+		DeleteReferralLinks(addresses ...string) error
 	}
 
 	// Stake - describes an interface for working with database models.
@@ -180,11 +194,36 @@ type (
 		InsertMsgDeleteSystemStakeAccountAddress(hash string, msgs ...stake.MsgDeleteSystemStakeAccountAddress) error
 
 		InsertMsgManageSystemStake(hash string, msgs ...stake.MsgManageSystemStake) error
+
+		InsertRewardEntries(entries ...bdtypes.StakeRewardEntry) error
+		GetRewardBalances(address string) ([]bdtypes.StakeRewardBalance, error)
+		GetRewardHistory(address string, offset, limit uint64) ([]bdtypes.StakeRewardEntry, error)
+
+		ApplySystemStakeAccountChange(change bdtypes.SystemStakeAccountChange) error
+		ApplySystemStakeChange(change bdtypes.SystemStakeChange) error
+		GetSystemStakeAccounts() ([]bdtypes.SystemStakeAccount, error)
+		GetSystemStakeTotals(height int64) ([]bdtypes.SystemStakeTotal, error)
+
+		InsertStakeBalanceChanges(changes ...bdtypes.StakeBalanceChange) error
+		GetStakeBalances() ([]bdtypes.StakeBalance, error)
+		RefreshStakeBalances(height int64, balances []bdtypes.StakeBalance, stale []string) error
+
+		InsertStakeTransfer(transfer bdtypes.StakeTransfer) error
+		RebuildStakeTransferFlows(startHeight, endHeight int64) error
+		GetStakeDailyFlows(address string, from, to time.Time) ([]bdtypes.StakeDailyFlow, error)
+		GetStakeTopCounterparties(address string, limit uint64) ([]bdtypes.StakeCounterpartyFlow, error)
+		GetStakeTotalFlow(address string) (bdtypes.StakeTotalFlow, error)
 	}
 )
 
 // custom sdk types
 type (
+	// Activity - describes an interface for working with database models.
+	Activity interface {
+		InsertAddressActivities(activities ...bdtypes.AddressActivity) error
+		GetAddressActivities(filter bdtypes.AddressActivityFilter) ([]bdtypes.AddressActivity, uint64, error)
+	}
+
 	// Bank - describes an interface for working with database models.
 	Bank interface {
 		GetAllMsgMultiSend(filter filter.Filter) ([]bank.MsgMultiSend, error)
@@ -192,6 +231,19 @@ type (
 
 		GetAllMsgSend(filter filter.Filter) ([]bank.MsgSend, error)
 		InsertMsgSend(hash string, msgs ...bank.MsgSend) error
+		UpdateMsgSendFee(hash string, fee sdk.Coins) error
+	}
+
+	// Events - describes an interface for working with database models.
+	Events interface {
+		GetAllTransferEvents(filter filter.Filter) ([]bdtypes.TransferEvent, error)
+		InsertTransferEvents(events ...bdtypes.TransferEvent) error
+
+		GetAllFeeChargedEvents(filter filter.Filter) ([]bdtypes.FeeChargedEvent, error)
+		InsertFeeChargedEvents(events ...bdtypes.FeeChargedEvent) error
+
+		GetAllRewardPaidEvents(filter filter.Filter) ([]bdtypes.RewardPaidEvent, error)
+		InsertRewardPaidEvents(events ...bdtypes.RewardPaidEvent) error
 	}
 
 	// LastBlock - describes an interface for working with database models.
@@ -2,4 +2,5 @@ package referral
 
 const (
 	tableSetReferrer = "overgold_referral_set_referrer"
+	tableLink        = "overgold_referral_link"
 )
@@ -0,0 +1,82 @@
+package referral
+
+import (
+	"database/sql"
+	"errors"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/lib/pq"
+
+	db "github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
+)
+
+// GetReferralLinks - method that get the current referrer of all the known addresses (overgold_referral_link).
+func (r Repository) GetReferralLinks() ([]bdtypes.ReferralLink, error) {
+	q := `SELECT referral_address, referrer_address, height FROM overgold_referral_link ORDER BY referral_address`
+
+	var result []db.DbReferralLink
+	if err := r.db.Select(&result, q); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableLink}
+	}
+
+	return toReferralLinkDomainList(result), nil
+}
+
+// GetReferralLink - method that get the current referrer of the given address (overgold_referral_link).
+func (r Repository) GetReferralLink(address string) (bdtypes.ReferralLink, error) {
+	q := `SELECT referral_address, referrer_address, height FROM overgold_referral_link WHERE referral_address = $1`
+
+	var result db.DbReferralLink
+	if err := r.db.Get(&result, q, address); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return bdtypes.ReferralLink{}, errs.NotFound{What: tableLink}
+		}
+
+		return bdtypes.ReferralLink{}, errs.Internal{Cause: err.Error()}
+	}
+
+	return toReferralLinkDomain(result), nil
+}
+
+// SaveReferralLinks - method that stores the current referrer of the given addresses (overgold_referral_link).
+// Links older than the stored ones are skipped.
+func (r Repository) SaveReferralLinks(links ...bdtypes.ReferralLink) error {
+	q := `
+		INSERT INTO overgold_referral_link (
+			referral_address, referrer_address, height
+		) VALUES (
+			$1, $2, $3
+		) ON CONFLICT (referral_address) DO UPDATE SET
+			referrer_address = excluded.referrer_address,
+			height = excluded.height
+		WHERE overgold_referral_link.height <= excluded.height
+	`
+
+	for _, link := range links {
+		m := toReferralLinkDatabase(link)
+		if _, err := r.db.Exec(q, m.ReferralAddress, m.ReferrerAddress, m.Height); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+// DeleteReferralLinks - method that deletes the referrer of the given addresses (overgold_referral_link).
+func (r Repository) DeleteReferralLinks(addresses ...string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	q := `DELETE FROM overgold_referral_link WHERE referral_address = ANY($1)`
+
+	if _, err := r.db.Exec(q, pq.Array(addresses)); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
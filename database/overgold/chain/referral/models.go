@@ -4,6 +4,7 @@ import (
 	"git.ooo.ua/vipcoin/ovg-chain/x/referral/types"
 
 	db "github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
 // toMsgSetReferrerDomain - mapping func to a domain model.
@@ -34,3 +35,27 @@ func toMsgSetReferrerDatabase(hash string, m types.MsgSetReferrer) db.DbReferral
 		ReferralAddress: m.ReferralAddress,
 	}
 }
+
+// toReferralLinkDomain - mapping func to a domain model.
+func toReferralLinkDomain(m db.DbReferralLink) bdtypes.ReferralLink {
+	return bdtypes.NewReferralLink(m.ReferralAddress, m.ReferrerAddress, m.Height)
+}
+
+// toReferralLinkDomainList - mapping func to a domain list.
+func toReferralLinkDomainList(m []db.DbReferralLink) []bdtypes.ReferralLink {
+	res := make([]bdtypes.ReferralLink, 0, len(m))
+	for _, link := range m {
+		res = append(res, toReferralLinkDomain(link))
+	}
+
+	return res
+}
+
+// toReferralLinkDatabase - mapping func to a database model.
+func toReferralLinkDatabase(m bdtypes.ReferralLink) db.DbReferralLink {
+	return db.DbReferralLink{
+		ReferralAddress: m.ReferralAddress,
+		ReferrerAddress: m.ReferrerAddress,
+		Height:          m.Height,
+	}
+}
@@ -0,0 +1,128 @@
+package stake
+
+import (
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	db "github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
+)
+
+// InsertStakeBalanceChanges - insert the given changes (overgold_stake_balance_change) and apply them
+// to the current stake of the related addresses (overgold_stake_balance). Changes that have already
+// been stored are skipped, as well as the ones already included inside the state read from the node
+// by the latest state refresh: the changes of a message must therefore be summed into a single entry
+// per address.
+func (r Repository) InsertStakeBalanceChanges(changes ...bdtypes.StakeBalanceChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	qChange := `
+		INSERT INTO overgold_stake_balance_change (
+			tx_hash, msg_index, height, address, amount, sell_amount
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		) ON CONFLICT (tx_hash, msg_index, address) DO NOTHING
+	`
+
+	qBalance := `
+		INSERT INTO overgold_stake_balance (
+			address, amount, sell_amount, height
+		) VALUES (
+			$1, $2, $3, $4
+		) ON CONFLICT (address) DO UPDATE SET
+			amount = overgold_stake_balance.amount + excluded.amount,
+			sell_amount = overgold_stake_balance.sell_amount + excluded.sell_amount,
+			height = GREATEST(overgold_stake_balance.height, excluded.height)
+		WHERE overgold_stake_balance.refreshed_height < excluded.height
+	`
+
+	return r.inTx(func(tx *sqlx.Tx) error {
+		for _, change := range changes {
+			m := toStakeBalanceChangeDatabase(change)
+
+			res, err := tx.Exec(qChange, m.TxHash, m.MsgIndex, m.Height, m.Address, m.Amount, m.SellAmount)
+			if err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+			if affected == 0 {
+				continue
+			}
+
+			if _, err = tx.Exec(qBalance, m.Address, m.Amount, m.SellAmount, m.Height); err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetStakeBalances - method that get the current non-empty stake of all the addresses (overgold_stake_balance).
+func (r Repository) GetStakeBalances() ([]bdtypes.StakeBalance, error) {
+	q := `
+		SELECT address, amount, sell_amount, height, refreshed_height
+		FROM overgold_stake_balance
+		WHERE amount <> 0 OR sell_amount <> 0
+		ORDER BY address
+	`
+
+	var result []db.StakeBalance
+	if err := r.db.Select(&result, q); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableBalance}
+	}
+
+	return toStakeBalanceDomainList(result)
+}
+
+// RefreshStakeBalances - method that overwrites the current stake of the given addresses with the one read
+// from the node at the given height, and empties the stake of the stale addresses (overgold_stake_balance).
+// The changes stored up to that height are not applied anymore to the refreshed addresses.
+func (r Repository) RefreshStakeBalances(height int64, balances []bdtypes.StakeBalance, stale []string) error {
+	qBalance := `
+		INSERT INTO overgold_stake_balance (
+			address, amount, sell_amount, height, refreshed_height
+		) VALUES (
+			$1, $2, $3, $4, $4
+		) ON CONFLICT (address) DO UPDATE SET
+			amount = excluded.amount,
+			sell_amount = excluded.sell_amount,
+			height = GREATEST(overgold_stake_balance.height, excluded.height),
+			refreshed_height = excluded.refreshed_height
+	`
+
+	qStale := `
+		UPDATE overgold_stake_balance
+		SET amount = 0, sell_amount = 0, height = GREATEST(height, $2), refreshed_height = $2
+		WHERE address = ANY($1)
+	`
+
+	return r.inTx(func(tx *sqlx.Tx) error {
+		for _, balance := range balances {
+			m := toStakeBalanceDatabase(balance)
+			if _, err := tx.Exec(qBalance, m.Address, m.Amount, m.SellAmount, height); err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+		}
+
+		if len(stale) == 0 {
+			return nil
+		}
+
+		if _, err := tx.Exec(qStale, pq.Array(stale), height); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		return nil
+	})
+}
@@ -1,5 +1,10 @@
 package stake
 
+import (
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/jmoiron/sqlx"
+)
+
 const (
 	tableSell              = "overgold_stake_sell"
 	tableBuy               = "overgold_stake_buy"
@@ -8,4 +13,33 @@ const (
 	tableClaimReward       = "overgold_stake_claim_reward"
 	tableTransferFromUser  = "overgold_stake_transfer_from_user"
 	tableTransferToUser    = "overgold_stake_transfer_to_user"
+	tableRewardLedger      = "overgold_stake_reward_ledger"
+	tableRewardBalance     = "overgold_stake_reward_balance"
+	tableSystemAccount     = "overgold_stake_system_account"
+	tableSystemStakeChange = "overgold_stake_system_stake_change"
+	tableBalance           = "overgold_stake_balance"
+
+	tableTransferFlowDaily        = "overgold_stake_transfer_flow_daily"
+	tableTransferFlowCounterparty = "overgold_stake_transfer_flow_counterparty"
+	tableTransferFlowTotal        = "overgold_stake_transfer_flow_total"
 )
+
+// inTx runs the given function inside a database transaction,
+// which is committed only if the function succeeds.
+func (r Repository) inTx(fn func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
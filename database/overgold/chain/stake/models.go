@@ -2,6 +2,7 @@ package stake
 
 import (
 	"strconv"
+	"time"
 
 	"git.ooo.ua/vipcoin/lib/errs"
 	chainDomain "git.ooo.ua/vipcoin/ovg-chain/x/domain"
@@ -9,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	db "github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
 // toMsgSellDomain - mapping func to a domain model.
@@ -272,3 +274,285 @@ func toMsgManageSystemStakeDatabase(hash string, m types.MsgManageSystemStake) (
 		Kind:    m.Kind,
 	}, nil
 }
+
+// toRewardEntryDomain - mapping func to a domain model.
+func toRewardEntryDomain(m db.StakeRewardLedger) (bdtypes.StakeRewardEntry, error) {
+	amount, ok := sdk.NewIntFromString(m.Amount)
+	if !ok {
+		return bdtypes.StakeRewardEntry{}, errs.Internal{Cause: "invalid reward amount: " + m.Amount}
+	}
+
+	return bdtypes.NewStakeRewardEntry(
+		m.TxHash, m.MsgIndex, m.Height, m.Address, m.Kind, sdk.NewCoin(m.Denom, amount), m.Timestamp,
+	), nil
+}
+
+// toRewardEntryDomainList - mapping func to a domain list.
+func toRewardEntryDomainList(m []db.StakeRewardLedger) ([]bdtypes.StakeRewardEntry, error) {
+	res := make([]bdtypes.StakeRewardEntry, 0, len(m))
+	for _, entry := range m {
+		e, err := toRewardEntryDomain(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, e)
+	}
+
+	return res, nil
+}
+
+// toRewardEntryDatabase - mapping func to a database model.
+func toRewardEntryDatabase(m bdtypes.StakeRewardEntry) db.StakeRewardLedger {
+	return db.StakeRewardLedger{
+		TxHash:    m.TxHash,
+		MsgIndex:  m.MsgIndex,
+		Height:    m.Height,
+		Address:   m.Address,
+		Kind:      m.Kind,
+		Denom:     m.Amount.Denom,
+		Amount:    m.Amount.Amount.String(),
+		Timestamp: m.Timestamp,
+	}
+}
+
+// toRewardBalanceDomain - mapping func to a domain model.
+func toRewardBalanceDomain(m db.StakeRewardBalance) (bdtypes.StakeRewardBalance, error) {
+	accrued, ok := sdk.NewIntFromString(m.Accrued)
+	if !ok {
+		return bdtypes.StakeRewardBalance{}, errs.Internal{Cause: "invalid accrued amount: " + m.Accrued}
+	}
+
+	claimed, ok := sdk.NewIntFromString(m.Claimed)
+	if !ok {
+		return bdtypes.StakeRewardBalance{}, errs.Internal{Cause: "invalid claimed amount: " + m.Claimed}
+	}
+
+	return bdtypes.NewStakeRewardBalance(
+		m.Address, sdk.NewCoin(m.Denom, accrued), sdk.NewCoin(m.Denom, claimed), m.Height,
+	), nil
+}
+
+// toRewardBalanceDomainList - mapping func to a domain list.
+func toRewardBalanceDomainList(m []db.StakeRewardBalance) ([]bdtypes.StakeRewardBalance, error) {
+	res := make([]bdtypes.StakeRewardBalance, 0, len(m))
+	for _, balance := range m {
+		b, err := toRewardBalanceDomain(balance)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, b)
+	}
+
+	return res, nil
+}
+
+// toSystemAccountDomain - mapping func to a domain model.
+func toSystemAccountDomain(m db.StakeSystemAccount) bdtypes.SystemStakeAccount {
+	return bdtypes.SystemStakeAccount{
+		Address:       m.Address,
+		Kind:          m.Kind,
+		Active:        m.Active,
+		CreatedHeight: m.CreatedHeight,
+		UpdatedHeight: m.UpdatedHeight,
+		DeletedHeight: m.DeletedHeight.Int64,
+	}
+}
+
+// toSystemAccountDomainList - mapping func to a domain list.
+func toSystemAccountDomainList(m []db.StakeSystemAccount) []bdtypes.SystemStakeAccount {
+	res := make([]bdtypes.SystemStakeAccount, 0, len(m))
+	for _, account := range m {
+		res = append(res, toSystemAccountDomain(account))
+	}
+
+	return res
+}
+
+// toSystemAccountHistoryDatabase - mapping func to a database model.
+func toSystemAccountHistoryDatabase(m bdtypes.SystemStakeAccountChange) db.StakeSystemAccountHistory {
+	return db.StakeSystemAccountHistory{
+		TxHash:          m.TxHash,
+		MsgIndex:        m.MsgIndex,
+		Height:          m.Height,
+		Action:          m.Action,
+		Creator:         m.Creator,
+		Address:         m.Address,
+		PreviousAddress: m.PreviousAddress,
+		Timestamp:       m.Timestamp,
+	}
+}
+
+// toSystemStakeTotalDomain - mapping func to a domain model.
+func toSystemStakeTotalDomain(m db.StakeSystemStakeTotal) (bdtypes.SystemStakeTotal, error) {
+	total, ok := sdk.NewIntFromString(m.Total)
+	if !ok {
+		return bdtypes.SystemStakeTotal{}, errs.Internal{Cause: "invalid system stake total: " + m.Total}
+	}
+
+	return bdtypes.SystemStakeTotal{
+		Kind:    m.Kind,
+		Address: m.Address,
+		Total:   total,
+		Height:  m.Height,
+	}, nil
+}
+
+// toSystemStakeTotalDomainList - mapping func to a domain list.
+func toSystemStakeTotalDomainList(m []db.StakeSystemStakeTotal) ([]bdtypes.SystemStakeTotal, error) {
+	res := make([]bdtypes.SystemStakeTotal, 0, len(m))
+	for _, total := range m {
+		t, err := toSystemStakeTotalDomain(total)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, t)
+	}
+
+	return res, nil
+}
+
+// toStakeTransferFlowDatabase - mapping func to the database models of both sides of a transfer.
+func toStakeTransferFlowDatabase(m bdtypes.StakeTransfer) []db.StakeTransferFlow {
+	date := m.Timestamp.Truncate(24 * time.Hour)
+	amount := m.Amount.String()
+
+	return []db.StakeTransferFlow{
+		{
+			TxHash:       m.TxHash,
+			MsgIndex:     m.MsgIndex,
+			Source:       m.Source,
+			Height:       m.Height,
+			Date:         date,
+			Address:      m.From,
+			Counterparty: m.To,
+			Direction:    bdtypes.StakeFlowDirectionOut,
+			Amount:       amount,
+		},
+		{
+			TxHash:       m.TxHash,
+			MsgIndex:     m.MsgIndex,
+			Source:       m.Source,
+			Height:       m.Height,
+			Date:         date,
+			Address:      m.To,
+			Counterparty: m.From,
+			Direction:    bdtypes.StakeFlowDirectionIn,
+			Amount:       amount,
+		},
+	}
+}
+
+// toStakeFlowDomain - mapping func to a domain model.
+func toStakeFlowDomain(amountIn, amountOut string) (bdtypes.StakeFlow, error) {
+	in, ok := sdk.NewIntFromString(amountIn)
+	if !ok {
+		return bdtypes.StakeFlow{}, errs.Internal{Cause: "invalid stake flow amount: " + amountIn}
+	}
+
+	out, ok := sdk.NewIntFromString(amountOut)
+	if !ok {
+		return bdtypes.StakeFlow{}, errs.Internal{Cause: "invalid stake flow amount: " + amountOut}
+	}
+
+	return bdtypes.StakeFlow{In: in, Out: out}, nil
+}
+
+// toStakeDailyFlowDomainList - mapping func to a domain list.
+func toStakeDailyFlowDomainList(m []db.StakeTransferFlowDaily) ([]bdtypes.StakeDailyFlow, error) {
+	res := make([]bdtypes.StakeDailyFlow, 0, len(m))
+	for _, daily := range m {
+		flow, err := toStakeFlowDomain(daily.AmountIn, daily.AmountOut)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, bdtypes.StakeDailyFlow{StakeFlow: flow, Address: daily.Address, Date: daily.Date})
+	}
+
+	return res, nil
+}
+
+// toStakeCounterpartyFlowDomainList - mapping func to a domain list.
+func toStakeCounterpartyFlowDomainList(m []db.StakeTransferFlowCounterparty) ([]bdtypes.StakeCounterpartyFlow, error) {
+	res := make([]bdtypes.StakeCounterpartyFlow, 0, len(m))
+	for _, counterparty := range m {
+		flow, err := toStakeFlowDomain(counterparty.AmountIn, counterparty.AmountOut)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, bdtypes.StakeCounterpartyFlow{
+			StakeFlow:    flow,
+			Address:      counterparty.Address,
+			Counterparty: counterparty.Counterparty,
+			Transfers:    counterparty.Transfers,
+		})
+	}
+
+	return res, nil
+}
+
+// toStakeTotalFlowDomain - mapping func to a domain model.
+func toStakeTotalFlowDomain(m db.StakeTransferFlowTotal) (bdtypes.StakeTotalFlow, error) {
+	flow, err := toStakeFlowDomain(m.AmountIn, m.AmountOut)
+	if err != nil {
+		return bdtypes.StakeTotalFlow{}, err
+	}
+
+	return bdtypes.StakeTotalFlow{StakeFlow: flow, Address: m.Address, Height: m.Height}, nil
+}
+
+// toStakeBalanceChangeDatabase - mapping func to a database model.
+func toStakeBalanceChangeDatabase(m bdtypes.StakeBalanceChange) db.StakeBalanceChange {
+	return db.StakeBalanceChange{
+		TxHash:     m.TxHash,
+		MsgIndex:   m.MsgIndex,
+		Height:     m.Height,
+		Address:    m.Address,
+		Amount:     m.Amount.String(),
+		SellAmount: m.SellAmount.String(),
+	}
+}
+
+// toStakeBalanceDomain - mapping func to a domain model.
+func toStakeBalanceDomain(m db.StakeBalance) (bdtypes.StakeBalance, error) {
+	amount, ok := sdk.NewIntFromString(m.Amount)
+	if !ok {
+		return bdtypes.StakeBalance{}, errs.Internal{Cause: "invalid stake amount: " + m.Amount}
+	}
+
+	sellAmount, ok := sdk.NewIntFromString(m.SellAmount)
+	if !ok {
+		return bdtypes.StakeBalance{}, errs.Internal{Cause: "invalid stake sell amount: " + m.SellAmount}
+	}
+
+	return bdtypes.NewStakeBalance(m.Address, amount, sellAmount, m.Height), nil
+}
+
+// toStakeBalanceDomainList - mapping func to a domain list.
+func toStakeBalanceDomainList(m []db.StakeBalance) ([]bdtypes.StakeBalance, error) {
+	res := make([]bdtypes.StakeBalance, 0, len(m))
+	for _, balance := range m {
+		b, err := toStakeBalanceDomain(balance)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, b)
+	}
+
+	return res, nil
+}
+
+// toStakeBalanceDatabase - mapping func to a database model.
+func toStakeBalanceDatabase(m bdtypes.StakeBalance) db.StakeBalance {
+	return db.StakeBalance{
+		Address:    m.Address,
+		Amount:     m.Amount.String(),
+		SellAmount: m.SellAmount.String(),
+		Height:     m.Height,
+	}
+}
@@ -0,0 +1,108 @@
+package stake
+
+import (
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/jmoiron/sqlx"
+
+	db "github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
+)
+
+// InsertRewardEntries - insert new entries in a reward ledger (overgold_stake_reward_ledger)
+// and update the reward balances of the related addresses (overgold_stake_reward_balance).
+// Entries that have already been stored are skipped, so that re-parsing a block does not
+// count the same reward twice: the amounts of a message must therefore be summed into a single
+// entry per address, kind and denom.
+func (r Repository) InsertRewardEntries(entries ...bdtypes.StakeRewardEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	qEntry := `
+		INSERT INTO overgold_stake_reward_ledger (
+			tx_hash, msg_index, height, address, kind, denom, amount, timestamp
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		) ON CONFLICT (tx_hash, msg_index, address, kind, denom) DO NOTHING
+	`
+
+	qBalance := `
+		INSERT INTO overgold_stake_reward_balance (
+			address, denom, accrued, claimed, height
+		) VALUES (
+			$1, $2, $3, $4, $5
+		) ON CONFLICT (address, denom) DO UPDATE SET
+			accrued = overgold_stake_reward_balance.accrued + excluded.accrued,
+			claimed = overgold_stake_reward_balance.claimed + excluded.claimed,
+			height = GREATEST(overgold_stake_reward_balance.height, excluded.height)
+	`
+
+	return r.inTx(func(tx *sqlx.Tx) error {
+		for _, entry := range entries {
+			m := toRewardEntryDatabase(entry)
+
+			res, err := tx.Exec(qEntry, m.TxHash, m.MsgIndex, m.Height, m.Address, m.Kind, m.Denom, m.Amount, m.Timestamp)
+			if err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+			if affected == 0 {
+				continue
+			}
+
+			accrued, claimed := "0", "0"
+			if m.Kind == bdtypes.StakeRewardKindClaim {
+				claimed = m.Amount
+			} else {
+				accrued = m.Amount
+			}
+
+			if _, err = tx.Exec(qBalance, m.Address, m.Denom, accrued, claimed, m.Height); err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetRewardBalances - method that get the reward balances of the given address (overgold_stake_reward_balance).
+func (r Repository) GetRewardBalances(address string) ([]bdtypes.StakeRewardBalance, error) {
+	q := `SELECT address, denom, accrued, claimed, height FROM overgold_stake_reward_balance WHERE address = $1 ORDER BY denom`
+
+	var result []db.StakeRewardBalance
+	if err := r.db.Select(&result, q, address); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableRewardBalance}
+	}
+
+	return toRewardBalanceDomainList(result)
+}
+
+// GetRewardHistory - method that get the reward ledger entries of the given address,
+// the newest first (overgold_stake_reward_ledger).
+func (r Repository) GetRewardHistory(address string, offset, limit uint64) ([]bdtypes.StakeRewardEntry, error) {
+	q := `
+		SELECT id, tx_hash, msg_index, height, address, kind, denom, amount, timestamp
+		FROM overgold_stake_reward_ledger
+		WHERE address = $1
+		ORDER BY height DESC, id DESC
+		OFFSET $2 LIMIT $3
+	`
+
+	var result []db.StakeRewardLedger
+	if err := r.db.Select(&result, q, address, offset, limit); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableRewardLedger}
+	}
+
+	return toRewardEntryDomainList(result)
+}
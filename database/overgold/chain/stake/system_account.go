@@ -0,0 +1,225 @@
+package stake
+
+import (
+	"database/sql"
+	"errors"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/jmoiron/sqlx"
+
+	db "github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
+)
+
+// systemAccountAtQuery selects the system stake account active right after the change stored at the given
+// height ($1) and message index ($2), which is empty when that change ($3 being the delete action)
+// removed the account.
+const systemAccountAtQuery = `
+	SELECT CASE WHEN action = $3 THEN '' ELSE address END
+	FROM overgold_stake_system_account_history
+	WHERE (height, msg_index) <= ($1, $2)
+	ORDER BY height DESC, msg_index DESC
+	LIMIT 1
+`
+
+// ApplySystemStakeAccountChange - store a change of the system stake account registry
+// (overgold_stake_system_account_history) and update the current state of the registry
+// (overgold_stake_system_account). Changes that have already been stored are skipped, while
+// changes older than the stored state only update the history.
+func (r Repository) ApplySystemStakeAccountChange(change bdtypes.SystemStakeAccountChange) error {
+	qPrevious := `
+		SELECT CASE WHEN action = $3 THEN '' ELSE address END
+		FROM overgold_stake_system_account_history
+		WHERE (height, msg_index) < ($1, $2)
+		ORDER BY height DESC, msg_index DESC
+		LIMIT 1
+	`
+
+	qHistory := `
+		INSERT INTO overgold_stake_system_account_history (
+			tx_hash, msg_index, height, action, creator, address, previous_address, timestamp
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		) ON CONFLICT (tx_hash, msg_index) DO NOTHING
+	`
+
+	// The change following the stored one, if any, has been stored before it and must now point to it
+	qNext := `
+		UPDATE overgold_stake_system_account_history SET
+			previous_address = $3,
+			address = CASE WHEN action = $4 THEN $3 ELSE address END
+		WHERE id = (
+			SELECT id FROM overgold_stake_system_account_history
+			WHERE (height, msg_index) > ($1, $2)
+			ORDER BY height, msg_index
+			LIMIT 1
+		)
+	`
+
+	qDeactivate := `
+		UPDATE overgold_stake_system_account
+		SET active = false, updated_height = $1, deleted_height = $1
+		WHERE active AND address <> $2 AND updated_height <= $1
+	`
+
+	// The account is active only when no other account has been changed afterwards
+	qActivate := `
+		INSERT INTO overgold_stake_system_account (
+			address, active, created_height, updated_height
+		) SELECT
+			$1, NOT EXISTS (
+				SELECT 1 FROM overgold_stake_system_account WHERE address <> $1 AND updated_height > $2
+			), $2, $2
+		ON CONFLICT (address) DO UPDATE SET
+			active = excluded.active,
+			created_height = LEAST(overgold_stake_system_account.created_height, excluded.created_height),
+			updated_height = excluded.updated_height,
+			deleted_height = NULL
+		WHERE excluded.updated_height >= overgold_stake_system_account.updated_height
+	`
+
+	return r.inTx(func(tx *sqlx.Tx) error {
+		var previous string
+		err := tx.Get(&previous, qPrevious, change.Height, change.MsgIndex, bdtypes.SystemStakeAccountActionDelete)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		change.PreviousAddress = previous
+		if change.Action == bdtypes.SystemStakeAccountActionDelete {
+			change.Address = previous
+		}
+
+		m := toSystemAccountHistoryDatabase(change)
+		res, err := tx.Exec(qHistory,
+			m.TxHash, m.MsgIndex, m.Height, m.Action, m.Creator, m.Address, m.PreviousAddress, m.Timestamp)
+		if err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		// The chain keeps a single system stake account, so any other account is no longer active
+		keep := change.Address
+		if change.Action == bdtypes.SystemStakeAccountActionDelete {
+			keep = ""
+		}
+
+		_, err = tx.Exec(qNext, change.Height, change.MsgIndex, keep, bdtypes.SystemStakeAccountActionDelete)
+		if err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		if _, err = tx.Exec(qDeactivate, change.Height, keep); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		if keep == "" {
+			return nil
+		}
+
+		if _, err = tx.Exec(qActivate, keep, change.Height); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		return nil
+	})
+}
+
+// ApplySystemStakeChange - store an amount of stake managed by the system (overgold_stake_system_stake_change)
+// and update the kind of the system stake account active at that moment. Changes that have already been
+// stored are skipped.
+func (r Repository) ApplySystemStakeChange(change bdtypes.SystemStakeChange) error {
+	qChange := `
+		INSERT INTO overgold_stake_system_stake_change (
+			tx_hash, msg_index, height, kind, amount
+		) VALUES (
+			$1, $2, $3, $4, $5
+		) ON CONFLICT (tx_hash, msg_index) DO NOTHING
+	`
+
+	qKind := `
+		UPDATE overgold_stake_system_account SET kind = $4, kind_height = $1
+		WHERE address = (` + systemAccountAtQuery + `) AND kind_height <= $1
+	`
+
+	return r.inTx(func(tx *sqlx.Tx) error {
+		res, err := tx.Exec(qChange,
+			change.TxHash, change.MsgIndex, change.Height, change.Kind, change.Amount.String())
+		if err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(qKind, change.Height, change.MsgIndex, bdtypes.SystemStakeAccountActionDelete, change.Kind)
+		if err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		return nil
+	})
+}
+
+// GetSystemStakeAccounts - method that get all the known system stake accounts (overgold_stake_system_account).
+func (r Repository) GetSystemStakeAccounts() ([]bdtypes.SystemStakeAccount, error) {
+	q := `
+		SELECT address, kind, active, created_height, updated_height, deleted_height
+		FROM overgold_stake_system_account
+		ORDER BY active DESC, updated_height DESC
+	`
+
+	var result []db.StakeSystemAccount
+	if err := r.db.Select(&result, q); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableSystemAccount}
+	}
+
+	return toSystemAccountDomainList(result), nil
+}
+
+// GetSystemStakeTotals - method that get the total stake managed by the system for each kind at the
+// given height, summing the stored changes (overgold_stake_system_stake_change) in the chain order.
+func (r Repository) GetSystemStakeTotals(height int64) ([]bdtypes.SystemStakeTotal, error) {
+	q := `
+		SELECT DISTINCT ON (c.kind) c.kind, c.total, c.height,
+			COALESCE((
+				SELECT CASE WHEN h.action = $2 THEN '' ELSE h.address END
+				FROM overgold_stake_system_account_history h
+				WHERE (h.height, h.msg_index) <= (c.height, c.msg_index)
+				ORDER BY h.height DESC, h.msg_index DESC
+				LIMIT 1
+			), '') AS address
+		FROM (
+			SELECT id, kind, height, msg_index,
+				SUM(amount) OVER (PARTITION BY kind ORDER BY height, msg_index, id) AS total
+			FROM overgold_stake_system_stake_change
+			WHERE height <= $1
+		) c
+		ORDER BY c.kind, c.height DESC, c.msg_index DESC, c.id DESC
+	`
+
+	var result []db.StakeSystemStakeTotal
+	if err := r.db.Select(&result, q, height, bdtypes.SystemStakeAccountActionDelete); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableSystemStakeChange}
+	}
+
+	return toSystemStakeTotalDomainList(result)
+}
@@ -0,0 +1,270 @@
+package stake
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	db "github.com/forbole/bdjuno/v4/database/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
+)
+
+// rawTransfersQuery selects the stake transfers (MsgTransferFromUser and MsgTransferToUser) stored inside
+// the messages of the successful transactions within a height range, together with their message index.
+// The raw message tables (overgold_stake_transfer_from_user and overgold_stake_transfer_to_user) keep a
+// single transfer per transaction, so they can not be used to rebuild the ledger.
+const rawTransfersQuery = `
+	SELECT t.hash AS tx_hash, (m.index - 1)::INT AS msg_index,
+		CASE WHEN m.msg->>'@type' LIKE '%.MsgTransferFromUser' THEN 'from_user' ELSE 'to_user' END AS source,
+		t.height, b.timestamp::DATE AS date,
+		CASE WHEN m.msg->>'@type' LIKE '%.MsgTransferFromUser' THEN m.msg->>'address' ELSE m.msg->>'creator' END AS sender,
+		CASE WHEN m.msg->>'@type' LIKE '%.MsgTransferFromUser' THEN m.msg->>'creator' ELSE m.msg->>'address' END AS recipient,
+		(m.msg->>'amount')::NUMERIC AS amount
+	FROM transaction t
+		CROSS JOIN LATERAL jsonb_array_elements(t.messages) WITH ORDINALITY AS m(msg, index)
+		JOIN block b ON b.height = t.height
+	WHERE t.height BETWEEN $1 AND $2 AND t.success
+		AND (m.msg->>'@type' LIKE '%.MsgTransferFromUser' OR m.msg->>'@type' LIKE '%.MsgTransferToUser')
+`
+
+// InsertStakeTransfer - store both sides of the given transfer inside the flow ledger
+// (overgold_stake_transfer_flow) and update the daily, counterparty and cumulative flows
+// of the involved addresses. Transfers that have already been stored are skipped.
+func (r Repository) InsertStakeTransfer(transfer bdtypes.StakeTransfer) error {
+	qFlow := `
+		INSERT INTO overgold_stake_transfer_flow (
+			tx_hash, msg_index, source, height, date, address, counterparty, direction, amount
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		) ON CONFLICT (tx_hash, msg_index, source, address, direction) DO NOTHING
+	`
+
+	return r.inTx(func(tx *sqlx.Tx) error {
+		for _, m := range toStakeTransferFlowDatabase(transfer) {
+			res, err := tx.Exec(qFlow,
+				m.TxHash, m.MsgIndex, m.Source, m.Height, m.Date, m.Address, m.Counterparty, m.Direction, m.Amount)
+			if err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return errs.Internal{Cause: err.Error()}
+			}
+			if affected == 0 {
+				continue
+			}
+
+			if err = addStakeFlow(tx, m); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// RebuildStakeTransferFlows - rebuild the flow ledger (overgold_stake_transfer_flow) for the given
+// height range starting from the messages of the stored transactions, and recompute the aggregated
+// flows of all the addresses involved.
+func (r Repository) RebuildStakeTransferFlows(startHeight, endHeight int64) error {
+	qAddresses := `SELECT DISTINCT address FROM overgold_stake_transfer_flow WHERE height BETWEEN $1 AND $2`
+
+	qDelete := `DELETE FROM overgold_stake_transfer_flow WHERE height BETWEEN $1 AND $2`
+
+	qInsert := `
+		WITH raw AS (` + rawTransfersQuery + `)
+		INSERT INTO overgold_stake_transfer_flow (
+			tx_hash, msg_index, source, height, date, address, counterparty, direction, amount
+		)
+		SELECT tx_hash, msg_index, source, height, date, sender, recipient, 'out', amount FROM raw
+		UNION ALL
+		SELECT tx_hash, msg_index, source, height, date, recipient, sender, 'in', amount FROM raw
+		ON CONFLICT (tx_hash, msg_index, source, address, direction) DO NOTHING
+	`
+
+	return r.inTx(func(tx *sqlx.Tx) error {
+		var previous []string
+		if err := tx.Select(&previous, qAddresses, startHeight, endHeight); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		if _, err := tx.Exec(qDelete, startHeight, endHeight); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		if _, err := tx.Exec(qInsert, startHeight, endHeight); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		var current []string
+		if err := tx.Select(&current, qAddresses, startHeight, endHeight); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+
+		return recomputeStakeFlows(tx, append(previous, current...))
+	})
+}
+
+// GetStakeDailyFlows - method that get the daily flows of the given address
+// within the given dates (overgold_stake_transfer_flow_daily).
+func (r Repository) GetStakeDailyFlows(address string, from, to time.Time) ([]bdtypes.StakeDailyFlow, error) {
+	q := `
+		SELECT address, date, amount_in, amount_out
+		FROM overgold_stake_transfer_flow_daily
+		WHERE address = $1 AND date BETWEEN $2 AND $3
+		ORDER BY date DESC
+	`
+
+	var result []db.StakeTransferFlowDaily
+	if err := r.db.Select(&result, q, address, from, to); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableTransferFlowDaily}
+	}
+
+	return toStakeDailyFlowDomainList(result)
+}
+
+// GetStakeTopCounterparties - method that get the counterparties that moved the most stake
+// with the given address (overgold_stake_transfer_flow_counterparty).
+func (r Repository) GetStakeTopCounterparties(address string, limit uint64) ([]bdtypes.StakeCounterpartyFlow, error) {
+	q := `
+		SELECT address, counterparty, amount_in, amount_out, transfers
+		FROM overgold_stake_transfer_flow_counterparty
+		WHERE address = $1
+		ORDER BY amount_in + amount_out DESC, counterparty
+		LIMIT $2
+	`
+
+	var result []db.StakeTransferFlowCounterparty
+	if err := r.db.Select(&result, q, address, limit); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+	if len(result) == 0 {
+		return nil, errs.NotFound{What: tableTransferFlowCounterparty}
+	}
+
+	return toStakeCounterpartyFlowDomainList(result)
+}
+
+// GetStakeTotalFlow - method that get the cumulative flow of the given address (overgold_stake_transfer_flow_total).
+func (r Repository) GetStakeTotalFlow(address string) (bdtypes.StakeTotalFlow, error) {
+	q := `SELECT address, amount_in, amount_out, height FROM overgold_stake_transfer_flow_total WHERE address = $1`
+
+	var result db.StakeTransferFlowTotal
+	if err := r.db.Get(&result, q, address); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return bdtypes.StakeTotalFlow{}, errs.NotFound{What: tableTransferFlowTotal}
+		}
+
+		return bdtypes.StakeTotalFlow{}, errs.Internal{Cause: err.Error()}
+	}
+
+	return toStakeTotalFlowDomain(result)
+}
+
+// addStakeFlow adds the given ledger entry to the aggregated flows of its address
+func addStakeFlow(tx *sqlx.Tx, m db.StakeTransferFlow) error {
+	qDaily := `
+		INSERT INTO overgold_stake_transfer_flow_daily (address, date, amount_in, amount_out)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (address, date) DO UPDATE SET
+			amount_in = overgold_stake_transfer_flow_daily.amount_in + excluded.amount_in,
+			amount_out = overgold_stake_transfer_flow_daily.amount_out + excluded.amount_out
+	`
+
+	qCounterparty := `
+		INSERT INTO overgold_stake_transfer_flow_counterparty (address, counterparty, amount_in, amount_out, transfers)
+		VALUES ($1, $2, $3, $4, 1)
+		ON CONFLICT (address, counterparty) DO UPDATE SET
+			amount_in = overgold_stake_transfer_flow_counterparty.amount_in + excluded.amount_in,
+			amount_out = overgold_stake_transfer_flow_counterparty.amount_out + excluded.amount_out,
+			transfers = overgold_stake_transfer_flow_counterparty.transfers + 1
+	`
+
+	qTotal := `
+		INSERT INTO overgold_stake_transfer_flow_total (address, amount_in, amount_out, height)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (address) DO UPDATE SET
+			amount_in = overgold_stake_transfer_flow_total.amount_in + excluded.amount_in,
+			amount_out = overgold_stake_transfer_flow_total.amount_out + excluded.amount_out,
+			height = GREATEST(overgold_stake_transfer_flow_total.height, excluded.height)
+	`
+
+	amountIn, amountOut := "0", "0"
+	if m.Direction == bdtypes.StakeFlowDirectionIn {
+		amountIn = m.Amount
+	} else {
+		amountOut = m.Amount
+	}
+
+	if _, err := tx.Exec(qDaily, m.Address, m.Date, amountIn, amountOut); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	if _, err := tx.Exec(qCounterparty, m.Address, m.Counterparty, amountIn, amountOut); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	if _, err := tx.Exec(qTotal, m.Address, amountIn, amountOut, m.Height); err != nil {
+		return errs.Internal{Cause: err.Error()}
+	}
+
+	return nil
+}
+
+// recomputeStakeFlows rebuilds the aggregated flows of the given addresses from the whole ledger
+func recomputeStakeFlows(tx *sqlx.Tx, addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	queries := []string{
+		`DELETE FROM overgold_stake_transfer_flow_daily WHERE address = ANY($1)`,
+		`DELETE FROM overgold_stake_transfer_flow_counterparty WHERE address = ANY($1)`,
+		`DELETE FROM overgold_stake_transfer_flow_total WHERE address = ANY($1)`,
+		`
+		INSERT INTO overgold_stake_transfer_flow_daily (address, date, amount_in, amount_out)
+		SELECT address, date,
+			COALESCE(SUM(amount) FILTER (WHERE direction = 'in'), 0),
+			COALESCE(SUM(amount) FILTER (WHERE direction = 'out'), 0)
+		FROM overgold_stake_transfer_flow
+		WHERE address = ANY($1)
+		GROUP BY address, date
+		`,
+		`
+		INSERT INTO overgold_stake_transfer_flow_counterparty (address, counterparty, amount_in, amount_out, transfers)
+		SELECT address, counterparty,
+			COALESCE(SUM(amount) FILTER (WHERE direction = 'in'), 0),
+			COALESCE(SUM(amount) FILTER (WHERE direction = 'out'), 0),
+			COUNT(*)
+		FROM overgold_stake_transfer_flow
+		WHERE address = ANY($1)
+		GROUP BY address, counterparty
+		`,
+		`
+		INSERT INTO overgold_stake_transfer_flow_total (address, amount_in, amount_out, height)
+		SELECT address,
+			COALESCE(SUM(amount) FILTER (WHERE direction = 'in'), 0),
+			COALESCE(SUM(amount) FILTER (WHERE direction = 'out'), 0),
+			MAX(height)
+		FROM overgold_stake_transfer_flow
+		WHERE address = ANY($1)
+		GROUP BY address
+		`,
+	}
+
+	for _, q := range queries {
+		if _, err := tx.Exec(q, pq.Array(addresses)); err != nil {
+			return errs.Internal{Cause: err.Error()}
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package database
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	dbtypes "github.com/forbole/bdjuno/v4/database/types"
 
@@ -30,6 +33,68 @@ func (db *Db) GetTokensPriceID() ([]string, error) {
 	return units, nil
 }
 
+// GetTokenPrice returns the most updated price stored for the unit having the given name in the given currency,
+// or nil if no price has been stored yet
+func (db *Db) GetTokenPrice(unitName string, currency string) (*types.TokenPrice, error) {
+	query := `SELECT * FROM token_price WHERE unit_name = $1 AND currency = $2`
+
+	var row dbtypes.TokenPriceRow
+	err := db.Sqlx.Get(&row, query, unitName, currency)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error while getting token price: %s", err)
+	}
+
+	price := types.NewTokenPrice(row.Name, row.Currency, row.Price, row.MarketCap, row.Timestamp)
+	return &price, nil
+}
+
+// GetTokenPricesHistory returns the historic prices stored having a timestamp between from (included)
+// and to (excluded), sorted by timestamp
+func (db *Db) GetTokenPricesHistory(from, to time.Time) ([]types.TokenPrice, error) {
+	query := `
+SELECT unit_name, currency, price, market_cap, timestamp FROM token_price_history 
+WHERE timestamp >= $1 AND timestamp < $2 
+ORDER BY timestamp`
+
+	var rows []dbtypes.TokenPriceRow
+	err := db.Sqlx.Select(&rows, query, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("error while getting token prices history: %s", err)
+	}
+
+	prices := make([]types.TokenPrice, len(rows))
+	for i, row := range rows {
+		prices[i] = types.NewTokenPrice(row.Name, row.Currency, row.Price, row.MarketCap, row.Timestamp)
+	}
+
+	return prices, nil
+}
+
+// GetTokenPriceCandlesEnd returns the timestamp of the latest historic price rolled up into the candles,
+// or of the earliest historic price if no candle has been stored yet.
+// It returns nil if there is no historic price at all
+func (db *Db) GetTokenPriceCandlesEnd() (*time.Time, error) {
+	query := `
+SELECT COALESCE(
+	(SELECT MAX(close_time) FROM token_price_candle_hourly), 
+	(SELECT MIN(timestamp) FROM token_price_history)
+)`
+
+	var end sql.NullTime
+	err := db.Sqlx.Get(&end, query)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting token price candles end: %s", err)
+	}
+
+	if !end.Valid {
+		return nil, nil
+	}
+	return &end.Time, nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveToken allows to save the given token details
@@ -68,18 +133,18 @@ func (db *Db) SaveTokensPrices(prices []types.TokenPrice) error {
 		return nil
 	}
 
-	query := `INSERT INTO token_price (unit_name, price, market_cap, timestamp) VALUES`
+	query := `INSERT INTO token_price (unit_name, currency, price, market_cap, timestamp) VALUES`
 	var param []interface{}
 
 	for i, ticker := range prices {
-		vi := i * 4
-		query += fmt.Sprintf("($%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4)
-		param = append(param, ticker.UnitName, ticker.Price, ticker.MarketCap, ticker.Timestamp)
+		vi := i * 5
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5)
+		param = append(param, ticker.UnitName, ticker.Currency, ticker.Price, ticker.MarketCap, ticker.Timestamp)
 	}
 
 	query = query[:len(query)-1] // Remove trailing ","
 	query += `
-ON CONFLICT (unit_name) DO UPDATE 
+ON CONFLICT (unit_name, currency) DO UPDATE 
 	SET price = excluded.price,
 	    market_cap = excluded.market_cap,
 	    timestamp = excluded.timestamp
@@ -99,13 +164,13 @@ func (db *Db) SaveTokenPricesHistory(prices []types.TokenPrice) error {
 		return nil
 	}
 
-	query := `INSERT INTO token_price_history (unit_name, price, market_cap, timestamp) VALUES`
+	query := `INSERT INTO token_price_history (unit_name, currency, price, market_cap, timestamp) VALUES`
 	var param []interface{}
 
 	for i, ticker := range prices {
-		vi := i * 4
-		query += fmt.Sprintf("($%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4)
-		param = append(param, ticker.UnitName, ticker.Price, ticker.MarketCap, ticker.Timestamp)
+		vi := i * 5
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5)
+		param = append(param, ticker.UnitName, ticker.Currency, ticker.Price, ticker.MarketCap, ticker.Timestamp)
 	}
 
 	query = query[:len(query)-1] // Remove trailing ","
@@ -121,3 +186,157 @@ ON CONFLICT ON CONSTRAINT unique_price_for_timestamp DO UPDATE
 
 	return nil
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveGoldPrice allows to save the given gold spot price as the most updated one
+func (db *Db) SaveGoldPrice(price types.GoldPrice) error {
+	query := `
+INSERT INTO gold_price (price, timestamp) 
+VALUES ($1, $2) 
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET price = excluded.price,
+        timestamp = excluded.timestamp
+WHERE gold_price.timestamp <= excluded.timestamp`
+
+	_, err := db.SQL.Exec(query, price.Price, price.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while saving gold price: %s", err)
+	}
+
+	return nil
+}
+
+// SaveGoldPriceHistory stores the given gold spot price as an historic one
+func (db *Db) SaveGoldPriceHistory(price types.GoldPrice) error {
+	query := `
+INSERT INTO gold_price_history (price, timestamp) 
+VALUES ($1, $2) 
+ON CONFLICT (timestamp) DO UPDATE 
+    SET price = excluded.price`
+
+	_, err := db.SQL.Exec(query, price.Price, price.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while storing gold price history: %s", err)
+	}
+
+	return nil
+}
+
+// SaveTokensNAV allows to save the given NAVs as the most updated ones
+func (db *Db) SaveTokensNAV(navs []types.TokenNAV) error {
+	if len(navs) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO token_nav (unit_name, grams_per_unit, spot_price, nav, timestamp) VALUES`
+	var param []interface{}
+
+	for i, nav := range navs {
+		vi := i * 5
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5)
+		param = append(param, nav.UnitName, nav.GramsPerUnit, nav.SpotPrice, nav.NAV, nav.Timestamp)
+	}
+
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT (unit_name) DO UPDATE 
+	SET grams_per_unit = excluded.grams_per_unit,
+	    spot_price = excluded.spot_price,
+	    nav = excluded.nav,
+	    timestamp = excluded.timestamp
+WHERE token_nav.timestamp <= excluded.timestamp`
+
+	_, err := db.SQL.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while saving tokens nav: %s", err)
+	}
+
+	return nil
+}
+
+// SaveTokensNAVHistory stores the given NAVs as historic ones
+func (db *Db) SaveTokensNAVHistory(navs []types.TokenNAV) error {
+	if len(navs) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO token_nav_history (unit_name, grams_per_unit, spot_price, nav, timestamp) VALUES`
+	var param []interface{}
+
+	for i, nav := range navs {
+		vi := i * 5
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5)
+		param = append(param, nav.UnitName, nav.GramsPerUnit, nav.SpotPrice, nav.NAV, nav.Timestamp)
+	}
+
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT ON CONSTRAINT unique_nav_for_timestamp DO UPDATE 
+	SET grams_per_unit = excluded.grams_per_unit,
+	    spot_price = excluded.spot_price,
+	    nav = excluded.nav`
+
+	_, err := db.SQL.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while storing tokens nav history: %s", err)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveTokenPriceCandles stores the given candles, merging them with the ones already stored for the same periods
+func (db *Db) SaveTokenPriceCandles(candles []types.TokenPriceCandle) error {
+	byPeriod := make(map[string][]types.TokenPriceCandle)
+	for _, candle := range candles {
+		byPeriod[candle.Period] = append(byPeriod[candle.Period], candle)
+	}
+
+	for period, periodCandles := range byPeriod {
+		if _, ok := types.CandlePeriods[period]; !ok {
+			return fmt.Errorf("invalid candle period: %s", period)
+		}
+
+		err := db.saveTokenPriceCandles(period, periodCandles)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveTokenPriceCandles stores the given candles of the given period
+func (db *Db) saveTokenPriceCandles(period string, candles []types.TokenPriceCandle) error {
+	table := "token_price_candle_" + period
+
+	query := fmt.Sprintf(`INSERT INTO %s (unit_name, currency, timestamp, open, high, low, close, open_time, close_time) VALUES`, table)
+	var param []interface{}
+
+	for i, candle := range candles {
+		vi := i * 9
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
+			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8, vi+9)
+		param = append(param, candle.UnitName, candle.Currency, candle.Timestamp,
+			candle.Open, candle.High, candle.Low, candle.Close, candle.OpenTime, candle.CloseTime)
+	}
+
+	query = query[:len(query)-1] // Remove trailing ","
+	query += fmt.Sprintf(`
+ON CONFLICT ON CONSTRAINT unique_%[2]s_candle DO UPDATE 
+	SET open = CASE WHEN excluded.open_time < %[1]s.open_time THEN excluded.open ELSE %[1]s.open END,
+	    open_time = LEAST(%[1]s.open_time, excluded.open_time),
+	    high = GREATEST(%[1]s.high, excluded.high),
+	    low = LEAST(%[1]s.low, excluded.low),
+	    close = CASE WHEN excluded.close_time >= %[1]s.close_time THEN excluded.close ELSE %[1]s.close END,
+	    close_time = GREATEST(%[1]s.close_time, excluded.close_time)`, table, period)
+
+	_, err := db.SQL.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while storing %s token price candles: %s", period, err)
+	}
+
+	return nil
+}
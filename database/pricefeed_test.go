@@ -45,12 +45,14 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPrice() {
 	tickers := []types.TokenPrice{
 		types.NewTokenPrice(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"atom",
+			"usd",
 			200.01,
 			20,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
@@ -63,12 +65,14 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPrice() {
 	expected := []dbtypes.TokenPriceRow{
 		dbtypes.NewTokenPriceRow(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		dbtypes.NewTokenPriceRow(
 			"atom",
+			"usd",
 			200.01,
 			20,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
@@ -86,12 +90,14 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPrice() {
 	tickers = []types.TokenPrice{
 		types.NewTokenPrice(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 05, 00, 000, time.UTC),
@@ -104,12 +110,14 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPrice() {
 	expected = []dbtypes.TokenPriceRow{
 		dbtypes.NewTokenPriceRow(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		dbtypes.NewTokenPriceRow(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 05, 00, 000, time.UTC),
@@ -132,24 +140,28 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceHistory() {
 	tickers := []types.TokenPrice{
 		types.NewTokenPrice(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"desmos",
+			"usd",
 			200.01,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
@@ -162,24 +174,28 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceHistory() {
 	expected := []dbtypes.TokenPriceRow{
 		dbtypes.NewTokenPriceRow(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		dbtypes.NewTokenPriceRow(
 			"desmos",
+			"usd",
 			200.01,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
 		),
 		dbtypes.NewTokenPriceRow(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		dbtypes.NewTokenPriceRow(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
@@ -198,24 +214,28 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceHistory() {
 	tickers = []types.TokenPrice{
 		types.NewTokenPrice(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"desmos",
+			"usd",
 			300.01,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		types.NewTokenPrice(
 			"atom",
+			"usd",
 			10,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
@@ -228,18 +248,21 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceHistory() {
 	expected = []dbtypes.TokenPriceRow{
 		dbtypes.NewTokenPriceRow(
 			"desmos",
+			"usd",
 			100.01,
 			10,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		dbtypes.NewTokenPriceRow(
 			"atom",
+			"usd",
 			1,
 			20,
 			time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC),
 		),
 		dbtypes.NewTokenPriceRow(
 			"desmos",
+			"usd",
 			300.01,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
@@ -247,6 +270,7 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceHistory() {
 
 		dbtypes.NewTokenPriceRow(
 			"atom",
+			"usd",
 			10,
 			20,
 			time.Date(2020, 10, 10, 15, 02, 00, 000, time.UTC),
@@ -261,3 +285,77 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceHistory() {
 		suite.Require().True(expected[i].Equals(row))
 	}
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveGoldPrice() {
+	err := suite.database.SaveGoldPrice(types.NewGoldPrice(1900, time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC)))
+	suite.Require().NoError(err)
+
+	// Older prices should not replace the current one
+	err = suite.database.SaveGoldPrice(types.NewGoldPrice(1800, time.Date(2020, 10, 10, 14, 00, 00, 000, time.UTC)))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.GoldPriceRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT price, timestamp FROM gold_price`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(1900.0, rows[0].Price)
+
+	err = suite.database.SaveGoldPriceHistory(types.NewGoldPrice(1900, time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC)))
+	suite.Require().NoError(err)
+	err = suite.database.SaveGoldPriceHistory(types.NewGoldPrice(1950, time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC)))
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.GoldPriceRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT price, timestamp FROM gold_price_history`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(1950.0, rows[0].Price)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTokensNAV() {
+	suite.insertToken("gold")
+
+	spot := types.NewGoldPrice(types.TroyOunceGrams*60, time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC))
+	navs := []types.TokenNAV{
+		types.NewTokenNAV("gold", 1, spot),
+		types.NewTokenNAV("mgold", 0.001, spot),
+	}
+
+	err := suite.database.SaveTokensNAV(navs)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveTokensNAVHistory(navs)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.TokenNAVRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM token_nav ORDER BY unit_name`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal("gold", rows[0].UnitName)
+	suite.Require().InDelta(60, rows[0].NAV, 1e-6)
+
+	rows = []dbtypes.TokenNAVRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM token_nav_history`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTokenPriceCurrencies() {
+	suite.insertToken("desmos")
+
+	timestamp := time.Date(2020, 10, 10, 15, 00, 00, 000, time.UTC)
+	err := suite.database.SaveTokensPrices([]types.TokenPrice{
+		types.NewTokenPrice("desmos", "usd", 100.01, 10, timestamp),
+		types.NewTokenPrice("desmos", "eur", 90.01, 9, timestamp),
+	})
+	suite.Require().NoError(err)
+
+	price, err := suite.database.GetTokenPrice("desmos", "eur")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(price)
+	suite.Require().Equal(90.01, price.Price)
+
+	price, err = suite.database.GetTokenPrice("desmos", "uah")
+	suite.Require().NoError(err)
+	suite.Require().Nil(price)
+}
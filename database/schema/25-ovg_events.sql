@@ -0,0 +1,54 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS overgold_event_transfer
+(
+    id          BIGSERIAL NOT NULL PRIMARY KEY,
+    tx_hash     TEXT      NOT NULL,
+    msg_index   INT       NOT NULL,
+    event_index INT       NOT NULL,
+    sender      TEXT      NOT NULL,
+    recipient   TEXT      NOT NULL,
+    amount      COIN[]    NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_overgold_event_transfer ON overgold_event_transfer (tx_hash, msg_index, event_index);
+CREATE INDEX idx_overgold_event_transfer_sender ON overgold_event_transfer (sender);
+CREATE INDEX idx_overgold_event_transfer_recipient ON overgold_event_transfer (recipient);
+
+CREATE TABLE IF NOT EXISTS overgold_event_fee_charged
+(
+    id          BIGSERIAL NOT NULL PRIMARY KEY,
+    tx_hash     TEXT      NOT NULL,
+    msg_index   INT       NOT NULL,
+    event_index INT       NOT NULL,
+    payer       TEXT      NOT NULL,
+    amount      COIN[]    NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_overgold_event_fee_charged ON overgold_event_fee_charged (tx_hash, msg_index, event_index);
+CREATE INDEX idx_overgold_event_fee_charged_payer ON overgold_event_fee_charged (payer);
+
+CREATE TABLE IF NOT EXISTS overgold_event_reward_paid
+(
+    id          BIGSERIAL NOT NULL PRIMARY KEY,
+    tx_hash     TEXT      NOT NULL,
+    msg_index   INT       NOT NULL,
+    event_index INT       NOT NULL,
+    recipient   TEXT      NOT NULL,
+    amount      COIN[]    NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_overgold_event_reward_paid ON overgold_event_reward_paid (tx_hash, msg_index, event_index);
+CREATE INDEX idx_overgold_event_reward_paid_recipient ON overgold_event_reward_paid (recipient);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_overgold_event_transfer;
+DROP INDEX IF EXISTS idx_overgold_event_transfer_sender;
+DROP INDEX IF EXISTS idx_overgold_event_transfer_recipient;
+DROP INDEX IF EXISTS idx_overgold_event_fee_charged;
+DROP INDEX IF EXISTS idx_overgold_event_fee_charged_payer;
+DROP INDEX IF EXISTS idx_overgold_event_reward_paid;
+DROP INDEX IF EXISTS idx_overgold_event_reward_paid_recipient;
+
+DROP TABLE IF EXISTS overgold_event_transfer CASCADE;
+DROP TABLE IF EXISTS overgold_event_fee_charged CASCADE;
+DROP TABLE IF EXISTS overgold_event_reward_paid CASCADE;
@@ -0,0 +1,33 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS overgold_stake_reward_ledger
+(
+    id        BIGSERIAL                   NOT NULL PRIMARY KEY,
+    tx_hash   TEXT                        NOT NULL,
+    msg_index INT                         NOT NULL,
+    height    BIGINT                      NOT NULL,
+    address   TEXT                        NOT NULL,
+    kind      TEXT                        NOT NULL,
+    denom     TEXT                        NOT NULL,
+    amount    NUMERIC                     NOT NULL,
+    timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_overgold_stake_reward_ledger ON overgold_stake_reward_ledger (tx_hash, msg_index, address, kind, denom);
+CREATE INDEX idx_overgold_stake_reward_ledger_address ON overgold_stake_reward_ledger (address, height);
+
+CREATE TABLE IF NOT EXISTS overgold_stake_reward_balance
+(
+    address TEXT    NOT NULL,
+    denom   TEXT    NOT NULL,
+    accrued NUMERIC NOT NULL DEFAULT 0,
+    claimed NUMERIC NOT NULL DEFAULT 0,
+    height  BIGINT  NOT NULL,
+    PRIMARY KEY (address, denom)
+);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_overgold_stake_reward_ledger;
+DROP INDEX IF EXISTS idx_overgold_stake_reward_ledger_address;
+
+DROP TABLE IF EXISTS overgold_stake_reward_ledger CASCADE;
+DROP TABLE IF EXISTS overgold_stake_reward_balance CASCADE;
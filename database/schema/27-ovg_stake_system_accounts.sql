@@ -0,0 +1,54 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS overgold_stake_system_account
+(
+    address        TEXT    NOT NULL PRIMARY KEY,
+    kind           TEXT    NOT NULL DEFAULT '',
+    kind_height    BIGINT  NOT NULL DEFAULT 0,
+    active         BOOLEAN NOT NULL,
+    created_height BIGINT  NOT NULL,
+    updated_height BIGINT  NOT NULL,
+    deleted_height BIGINT
+);
+
+CREATE INDEX idx_overgold_stake_system_account_active ON overgold_stake_system_account (active);
+
+CREATE TABLE IF NOT EXISTS overgold_stake_system_account_history
+(
+    id               BIGSERIAL                   NOT NULL PRIMARY KEY,
+    tx_hash          TEXT                        NOT NULL,
+    msg_index        INT                         NOT NULL,
+    height           BIGINT                      NOT NULL,
+    action           TEXT                        NOT NULL,
+    creator          TEXT                        NOT NULL,
+    address          TEXT                        NOT NULL,
+    previous_address TEXT                        NOT NULL,
+    timestamp        TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_overgold_stake_system_account_history ON overgold_stake_system_account_history (tx_hash, msg_index);
+CREATE INDEX idx_overgold_stake_system_account_history_height ON overgold_stake_system_account_history (height, msg_index);
+
+/* Amounts of stake managed by the system, the totals are computed summing them in (height, msg_index) order */
+CREATE TABLE IF NOT EXISTS overgold_stake_system_stake_change
+(
+    id        BIGSERIAL NOT NULL PRIMARY KEY,
+    tx_hash   TEXT      NOT NULL,
+    msg_index INT       NOT NULL,
+    height    BIGINT    NOT NULL,
+    kind      TEXT      NOT NULL,
+    amount    NUMERIC   NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_overgold_stake_system_stake_change ON overgold_stake_system_stake_change (tx_hash, msg_index);
+CREATE INDEX idx_overgold_stake_system_stake_change_kind ON overgold_stake_system_stake_change (kind, height, msg_index);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_overgold_stake_system_account_active;
+DROP INDEX IF EXISTS idx_overgold_stake_system_account_history;
+DROP INDEX IF EXISTS idx_overgold_stake_system_account_history_height;
+DROP INDEX IF EXISTS idx_overgold_stake_system_stake_change;
+DROP INDEX IF EXISTS idx_overgold_stake_system_stake_change_kind;
+
+DROP TABLE IF EXISTS overgold_stake_system_account CASCADE;
+DROP TABLE IF EXISTS overgold_stake_system_account_history CASCADE;
+DROP TABLE IF EXISTS overgold_stake_system_stake_change CASCADE;
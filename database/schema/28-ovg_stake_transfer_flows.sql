@@ -0,0 +1,55 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS overgold_stake_transfer_flow
+(
+    id           BIGSERIAL NOT NULL PRIMARY KEY,
+    tx_hash      TEXT      NOT NULL,
+    msg_index    INT       NOT NULL,
+    source       TEXT      NOT NULL,
+    height       BIGINT    NOT NULL,
+    date         DATE      NOT NULL,
+    address      TEXT      NOT NULL,
+    counterparty TEXT      NOT NULL,
+    direction    TEXT      NOT NULL,
+    amount       NUMERIC   NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_overgold_stake_transfer_flow ON overgold_stake_transfer_flow (tx_hash, msg_index, source, address, direction);
+CREATE INDEX idx_overgold_stake_transfer_flow_height ON overgold_stake_transfer_flow (height);
+CREATE INDEX idx_overgold_stake_transfer_flow_address ON overgold_stake_transfer_flow (address, date);
+
+CREATE TABLE IF NOT EXISTS overgold_stake_transfer_flow_daily
+(
+    address    TEXT    NOT NULL,
+    date       DATE    NOT NULL,
+    amount_in  NUMERIC NOT NULL DEFAULT 0,
+    amount_out NUMERIC NOT NULL DEFAULT 0,
+    PRIMARY KEY (address, date)
+);
+
+CREATE TABLE IF NOT EXISTS overgold_stake_transfer_flow_counterparty
+(
+    address      TEXT    NOT NULL,
+    counterparty TEXT    NOT NULL,
+    amount_in    NUMERIC NOT NULL DEFAULT 0,
+    amount_out   NUMERIC NOT NULL DEFAULT 0,
+    transfers    BIGINT  NOT NULL DEFAULT 0,
+    PRIMARY KEY (address, counterparty)
+);
+
+CREATE TABLE IF NOT EXISTS overgold_stake_transfer_flow_total
+(
+    address    TEXT    NOT NULL PRIMARY KEY,
+    amount_in  NUMERIC NOT NULL DEFAULT 0,
+    amount_out NUMERIC NOT NULL DEFAULT 0,
+    height     BIGINT  NOT NULL
+);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_overgold_stake_transfer_flow;
+DROP INDEX IF EXISTS idx_overgold_stake_transfer_flow_height;
+DROP INDEX IF EXISTS idx_overgold_stake_transfer_flow_address;
+
+DROP TABLE IF EXISTS overgold_stake_transfer_flow CASCADE;
+DROP TABLE IF EXISTS overgold_stake_transfer_flow_daily CASCADE;
+DROP TABLE IF EXISTS overgold_stake_transfer_flow_counterparty CASCADE;
+DROP TABLE IF EXISTS overgold_stake_transfer_flow_total CASCADE;
@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS overgold_address_activity
+(
+    id        BIGSERIAL                   NOT NULL PRIMARY KEY,
+    tx_hash   TEXT                        NOT NULL,
+    msg_index INT                         NOT NULL,
+    height    BIGINT                      NOT NULL,
+    address   TEXT                        NOT NULL,
+    role      TEXT                        NOT NULL,
+    module    TEXT                        NOT NULL,
+    msg_type  TEXT                        NOT NULL,
+    timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_overgold_address_activity ON overgold_address_activity (tx_hash, msg_index, address, role);
+CREATE INDEX idx_overgold_address_activity_address ON overgold_address_activity (address, height DESC);
+CREATE INDEX idx_overgold_address_activity_msg_type ON overgold_address_activity (msg_type);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_overgold_address_activity;
+DROP INDEX IF EXISTS idx_overgold_address_activity_address;
+DROP INDEX IF EXISTS idx_overgold_address_activity_msg_type;
+
+DROP TABLE IF EXISTS overgold_address_activity CASCADE;
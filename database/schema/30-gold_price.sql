@@ -0,0 +1,58 @@
+-- +migrate Up
+/* ---- GOLD SPOT PRICE ---- */
+
+CREATE TABLE gold_price
+(
+    one_row_id BOOLEAN                     NOT NULL DEFAULT TRUE PRIMARY KEY,
+    /* USD price of a troy ounce */
+    price      DECIMAL                     NOT NULL,
+    timestamp  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    CHECK (one_row_id)
+);
+
+CREATE TABLE gold_price_history
+(
+    price     DECIMAL                     NOT NULL,
+    timestamp TIMESTAMP WITHOUT TIME ZONE NOT NULL UNIQUE
+);
+
+
+/* ---- GOLD-BACKED TOKENS NAV ---- */
+
+CREATE TABLE token_nav
+(
+    unit_name      TEXT                        NOT NULL REFERENCES token_unit (denom) UNIQUE,
+    grams_per_unit DECIMAL                     NOT NULL,
+    spot_price     DECIMAL                     NOT NULL,
+    nav            DECIMAL                     NOT NULL,
+    timestamp      TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+
+CREATE TABLE token_nav_history
+(
+    unit_name      TEXT                        NOT NULL REFERENCES token_unit (denom),
+    grams_per_unit DECIMAL                     NOT NULL,
+    spot_price     DECIMAL                     NOT NULL,
+    nav            DECIMAL                     NOT NULL,
+    timestamp      TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    CONSTRAINT unique_nav_for_timestamp UNIQUE (unit_name, timestamp)
+);
+CREATE INDEX token_nav_history_timestamp_index ON token_nav_history (timestamp);
+
+/* Market price of each gold-backed unit next to its NAV, with the premium (positive) or discount (negative) */
+CREATE VIEW token_valuation AS
+SELECT token_nav.unit_name,
+       token_price.price                                          AS market_price,
+       token_nav.nav,
+       (token_price.price - token_nav.nav) / NULLIF(token_nav.nav, 0) AS premium,
+       token_nav.timestamp
+FROM token_nav
+         LEFT JOIN token_price ON token_price.unit_name = token_nav.unit_name;
+
+-- +migrate Down
+DROP VIEW IF EXISTS token_valuation;
+DROP INDEX IF EXISTS token_nav_history_timestamp_index;
+DROP TABLE IF EXISTS token_nav_history CASCADE;
+DROP TABLE IF EXISTS token_nav CASCADE;
+DROP TABLE IF EXISTS gold_price_history CASCADE;
+DROP TABLE IF EXISTS gold_price CASCADE;
@@ -0,0 +1,52 @@
+-- +migrate Up
+/* ---- TOKEN PRICES CURRENCY ---- */
+
+ALTER TABLE token_price
+    ADD COLUMN currency TEXT NOT NULL DEFAULT 'usd';
+ALTER TABLE token_price
+    DROP CONSTRAINT IF EXISTS token_price_unit_name_key;
+ALTER TABLE token_price
+    ADD CONSTRAINT unique_price_for_currency UNIQUE (unit_name, currency);
+
+ALTER TABLE token_price_history
+    ADD COLUMN currency TEXT NOT NULL DEFAULT 'usd';
+ALTER TABLE token_price_history
+    DROP CONSTRAINT IF EXISTS unique_price_for_timestamp;
+ALTER TABLE token_price_history
+    ADD CONSTRAINT unique_price_for_timestamp UNIQUE (unit_name, currency, timestamp);
+
+/* NAVs are computed from the USD gold spot price, so they are compared with the USD market price only */
+CREATE OR REPLACE VIEW token_valuation AS
+SELECT token_nav.unit_name,
+       token_price.price                                              AS market_price,
+       token_nav.nav,
+       (token_price.price - token_nav.nav) / NULLIF(token_nav.nav, 0) AS premium,
+       token_nav.timestamp
+FROM token_nav
+         LEFT JOIN token_price ON token_price.unit_name = token_nav.unit_name AND token_price.currency = 'usd';
+
+-- +migrate Down
+CREATE OR REPLACE VIEW token_valuation AS
+SELECT token_nav.unit_name,
+       token_price.price                                              AS market_price,
+       token_nav.nav,
+       (token_price.price - token_nav.nav) / NULLIF(token_nav.nav, 0) AS premium,
+       token_nav.timestamp
+FROM token_nav
+         LEFT JOIN token_price ON token_price.unit_name = token_nav.unit_name;
+
+DELETE FROM token_price_history WHERE currency <> 'usd';
+ALTER TABLE token_price_history
+    DROP CONSTRAINT IF EXISTS unique_price_for_timestamp;
+ALTER TABLE token_price_history
+    ADD CONSTRAINT unique_price_for_timestamp UNIQUE (unit_name, timestamp);
+ALTER TABLE token_price_history
+    DROP COLUMN currency;
+
+DELETE FROM token_price WHERE currency <> 'usd';
+ALTER TABLE token_price
+    DROP CONSTRAINT IF EXISTS unique_price_for_currency;
+ALTER TABLE token_price
+    ADD CONSTRAINT token_price_unit_name_key UNIQUE (unit_name);
+ALTER TABLE token_price
+    DROP COLUMN currency;
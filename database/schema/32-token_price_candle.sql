@@ -0,0 +1,42 @@
+-- +migrate Up
+/* ---- TOKEN PRICE CANDLES ---- */
+
+CREATE TABLE token_price_candle_hourly
+(
+    unit_name  TEXT                        NOT NULL REFERENCES token_unit (denom),
+    currency   TEXT                        NOT NULL,
+    /* Start of the period */
+    timestamp  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    open       DECIMAL                     NOT NULL,
+    high       DECIMAL                     NOT NULL,
+    low        DECIMAL                     NOT NULL,
+    close      DECIMAL                     NOT NULL,
+    /* Timestamps of the first and last prices of the period */
+    open_time  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    close_time TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    CONSTRAINT unique_hourly_candle UNIQUE (unit_name, currency, timestamp)
+);
+CREATE INDEX token_price_candle_hourly_timestamp_index ON token_price_candle_hourly (timestamp);
+
+CREATE TABLE token_price_candle_daily
+(
+    unit_name  TEXT                        NOT NULL REFERENCES token_unit (denom),
+    currency   TEXT                        NOT NULL,
+    /* Start of the period */
+    timestamp  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    open       DECIMAL                     NOT NULL,
+    high       DECIMAL                     NOT NULL,
+    low        DECIMAL                     NOT NULL,
+    close      DECIMAL                     NOT NULL,
+    /* Timestamps of the first and last prices of the period */
+    open_time  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    close_time TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    CONSTRAINT unique_daily_candle UNIQUE (unit_name, currency, timestamp)
+);
+CREATE INDEX token_price_candle_daily_timestamp_index ON token_price_candle_daily (timestamp);
+
+-- +migrate Down
+DROP INDEX IF EXISTS token_price_candle_daily_timestamp_index;
+DROP TABLE IF EXISTS token_price_candle_daily CASCADE;
+DROP INDEX IF EXISTS token_price_candle_hourly_timestamp_index;
+DROP TABLE IF EXISTS token_price_candle_hourly CASCADE;
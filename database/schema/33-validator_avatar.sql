@@ -0,0 +1,18 @@
+-- +migrate Up
+/* Avatars resolved for each validator, refreshed periodically instead of on every description update */
+CREATE TABLE validator_avatar
+(
+    operator_address TEXT                        NOT NULL PRIMARY KEY,
+    /* Identity and website the avatar has been resolved from */
+    identity         TEXT,
+    website          TEXT,
+    avatar_url       TEXT,
+    /* Name of the resolver that found the avatar */
+    source           TEXT,
+    updated_at       TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE INDEX validator_avatar_updated_at_index ON validator_avatar (updated_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS validator_avatar_updated_at_index;
+DROP TABLE IF EXISTS validator_avatar CASCADE;
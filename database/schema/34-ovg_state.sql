@@ -0,0 +1,22 @@
+-- +migrate Up
+/* Current referrer of each address, kept up to date by the set referrer messages and the state refresh */
+CREATE TABLE IF NOT EXISTS overgold_referral_link
+(
+    referral_address TEXT   NOT NULL PRIMARY KEY,
+    referrer_address TEXT   NOT NULL,
+    height           BIGINT NOT NULL
+);
+
+CREATE INDEX idx_overgold_referral_link_referrer ON overgold_referral_link (referrer_address);
+
+INSERT INTO overgold_referral_link (referral_address, referrer_address, height)
+SELECT DISTINCT ON (s.referral_address) s.referral_address, s.referrer_address, t.height
+FROM overgold_referral_set_referrer s
+         JOIN transaction t ON t.hash = s.tx_hash
+ORDER BY s.referral_address, t.height DESC, s.id DESC
+ON CONFLICT DO NOTHING;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_overgold_referral_link_referrer;
+
+DROP TABLE IF EXISTS overgold_referral_link CASCADE;
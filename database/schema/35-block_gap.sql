@@ -0,0 +1,18 @@
+-- +migrate Up
+/* Ranges of heights found missing from the block table, along with the outcome of their refetch */
+CREATE TABLE block_gap
+(
+    start_height      BIGINT    NOT NULL,
+    end_height        BIGINT    NOT NULL,
+    source            TEXT      NOT NULL,
+    missing           BIGINT    NOT NULL,
+    error             TEXT,
+    first_detected_at TIMESTAMP NOT NULL,
+    last_checked_at   TIMESTAMP NOT NULL,
+    filled_at         TIMESTAMP,
+    PRIMARY KEY (start_height, end_height)
+);
+CREATE INDEX block_gap_filled_at_index ON block_gap (filled_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS block_gap;
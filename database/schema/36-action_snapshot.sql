@@ -0,0 +1,15 @@
+-- +migrate Up
+/* Latest response returned by the node for each action request, served while the node is unavailable */
+CREATE TABLE action_snapshot
+(
+    path        TEXT      NOT NULL,
+    /* Request arguments, excluding the height */
+    request_key TEXT      NOT NULL,
+    response    JSONB     NOT NULL,
+    height      BIGINT    NOT NULL,
+    updated_at  TIMESTAMP NOT NULL,
+    PRIMARY KEY (path, request_key)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS action_snapshot;
@@ -0,0 +1,8 @@
+-- +migrate Up
+/* Fees actually charged while executing the sends, read from the emitted fee_charged events */
+ALTER TABLE msg_send ADD COLUMN IF NOT EXISTS fee COIN[] NOT NULL DEFAULT '{}';
+ALTER TABLE overgold_core_send ADD COLUMN IF NOT EXISTS fee COIN[] NOT NULL DEFAULT '{}';
+
+-- +migrate Down
+ALTER TABLE overgold_core_send DROP COLUMN IF EXISTS fee;
+ALTER TABLE msg_send DROP COLUMN IF EXISTS fee;
@@ -0,0 +1,91 @@
+-- +migrate Up
+/* Changes to the stake of each address caused by the buy, sell, sell cancel and transfer messages */
+CREATE TABLE IF NOT EXISTS overgold_stake_balance_change
+(
+    id          BIGSERIAL NOT NULL PRIMARY KEY,
+    tx_hash     TEXT      NOT NULL,
+    msg_index   INT       NOT NULL,
+    height      BIGINT    NOT NULL,
+    address     TEXT      NOT NULL,
+    amount      NUMERIC   NOT NULL,
+    sell_amount NUMERIC   NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_overgold_stake_balance_change ON overgold_stake_balance_change (tx_hash, msg_index, address);
+CREATE INDEX idx_overgold_stake_balance_change_address ON overgold_stake_balance_change (address, height);
+
+/* Current stake of each address, kept up to date by the stake messages and the state refresh.
+   Changes stored at or below refreshed_height are already included inside the state read from the node. */
+CREATE TABLE IF NOT EXISTS overgold_stake_balance
+(
+    address          TEXT    NOT NULL PRIMARY KEY,
+    amount           NUMERIC NOT NULL DEFAULT 0,
+    sell_amount      NUMERIC NOT NULL DEFAULT 0,
+    height           BIGINT  NOT NULL,
+    refreshed_height BIGINT  NOT NULL DEFAULT 0
+);
+
+WITH msgs AS (SELECT t.hash                   AS tx_hash,
+                     (m.index - 1)::INT       AS msg_index,
+                     t.height,
+                     m.msg ->> '@type'        AS type,
+                     m.msg ->> 'creator'      AS creator,
+                     m.msg ->> 'address'      AS address,
+                     CASE
+                         WHEN m.msg ->> '@type' LIKE '%.MsgMsgCancelSell' THEN (m.msg -> 'amount' ->> 'amount')::NUMERIC
+                         ELSE (m.msg ->> 'amount')::NUMERIC
+                         END                  AS amount
+              FROM transaction t
+                       CROSS JOIN LATERAL jsonb_array_elements(t.messages) WITH ORDINALITY AS m(msg, index)
+              WHERE t.success
+                AND (m.msg ->> '@type' LIKE '%.MsgBuyRequest'
+                  OR m.msg ->> '@type' LIKE '%.MsgSellRequest'
+                  OR m.msg ->> '@type' LIKE '%.MsgMsgCancelSell'
+                  OR m.msg ->> '@type' LIKE '%.MsgTransferFromUser'
+                  OR m.msg ->> '@type' LIKE '%.MsgTransferToUser')),
+     changes AS (SELECT tx_hash, msg_index, height, creator AS address, amount, 0 AS sell_amount
+                 FROM msgs
+                 WHERE type LIKE '%.MsgBuyRequest'
+                 UNION ALL
+                 SELECT tx_hash, msg_index, height, creator, -amount, amount
+                 FROM msgs
+                 WHERE type LIKE '%.MsgSellRequest'
+                 UNION ALL
+                 SELECT tx_hash, msg_index, height, creator, amount, -amount
+                 FROM msgs
+                 WHERE type LIKE '%.MsgMsgCancelSell'
+                 UNION ALL
+                 SELECT tx_hash, msg_index, height, address, -amount, 0
+                 FROM msgs
+                 WHERE type LIKE '%.MsgTransferFromUser'
+                 UNION ALL
+                 SELECT tx_hash, msg_index, height, creator, amount, 0
+                 FROM msgs
+                 WHERE type LIKE '%.MsgTransferFromUser'
+                 UNION ALL
+                 SELECT tx_hash, msg_index, height, creator, -amount, 0
+                 FROM msgs
+                 WHERE type LIKE '%.MsgTransferToUser'
+                 UNION ALL
+                 SELECT tx_hash, msg_index, height, address, amount, 0
+                 FROM msgs
+                 WHERE type LIKE '%.MsgTransferToUser')
+INSERT
+INTO overgold_stake_balance_change (tx_hash, msg_index, height, address, amount, sell_amount)
+SELECT tx_hash, msg_index, height, address, SUM(amount), SUM(sell_amount)
+FROM changes
+GROUP BY tx_hash, msg_index, height, address
+ON CONFLICT DO NOTHING;
+
+INSERT INTO overgold_stake_balance (address, amount, sell_amount, height)
+SELECT address, SUM(amount), SUM(sell_amount), MAX(height)
+FROM overgold_stake_balance_change
+GROUP BY address
+ON CONFLICT DO NOTHING;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_overgold_stake_balance_change;
+DROP INDEX IF EXISTS idx_overgold_stake_balance_change_address;
+
+DROP TABLE IF EXISTS overgold_stake_balance_change CASCADE;
+DROP TABLE IF EXISTS overgold_stake_balance CASCADE;
@@ -0,0 +1,9 @@
+// Package schema contains the SQL files creating the database schema, which are applied in order as migrations
+package schema
+
+import "embed"
+
+// FS contains the schema files, embedded inside the binary so that migrations can be run from any directory
+//
+//go:embed *.sql
+var FS embed.FS
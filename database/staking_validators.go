@@ -494,3 +494,131 @@ VALUES `
 
 	return nil
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveValidatorAvatar stores the given resolved validator avatar
+func (db *Db) SaveValidatorAvatar(avatar types.ValidatorAvatar) error {
+	stmt := `
+INSERT INTO validator_avatar (operator_address, identity, website, avatar_url, source, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (operator_address) DO UPDATE
+    SET identity = excluded.identity,
+        website = excluded.website,
+        avatar_url = excluded.avatar_url,
+        source = excluded.source,
+        updated_at = excluded.updated_at
+WHERE validator_avatar.updated_at <= excluded.updated_at`
+
+	_, err := db.SQL.Exec(stmt,
+		avatar.OperatorAddress,
+		dbtypes.ToNullString(avatar.Identity),
+		dbtypes.ToNullString(avatar.Website),
+		dbtypes.ToNullString(avatar.AvatarURL),
+		dbtypes.ToNullString(avatar.Source),
+		avatar.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing validator avatar: %s", err)
+	}
+
+	return nil
+}
+
+// GetValidatorAvatar returns the avatar resolved for the validator having the given operator address,
+// or nil if it has not been resolved yet
+func (db *Db) GetValidatorAvatar(opAddr string) (*types.ValidatorAvatar, error) {
+	var rows []dbtypes.ValidatorAvatarRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_avatar WHERE operator_address = $1`, opAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validator avatar: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	row := rows[0]
+	avatar := types.NewValidatorAvatar(
+		row.OperatorAddress,
+		row.Identity.String,
+		row.Website.String,
+		row.AvatarURL.String,
+		row.Source.String,
+		row.UpdatedAt,
+	)
+	return &avatar, nil
+}
+
+// GetValidatorsDescriptions returns the stored description of every validator
+func (db *Db) GetValidatorsDescriptions() ([]types.ValidatorDescription, error) {
+	stmt := `
+SELECT validator_info.operator_address, validator_description.*
+FROM validator_description
+    JOIN validator_info ON validator_info.consensus_address = validator_description.validator_address`
+
+	return db.getValidatorsDescriptions(stmt)
+}
+
+// GetValidatorsDescriptionsWithStaleAvatar returns the stored description of the validators whose avatar
+// has never been resolved, or has been resolved from a different identity or website
+func (db *Db) GetValidatorsDescriptionsWithStaleAvatar() ([]types.ValidatorDescription, error) {
+	stmt := `
+SELECT validator_info.operator_address, validator_description.*
+FROM validator_description
+    JOIN validator_info ON validator_info.consensus_address = validator_description.validator_address
+    LEFT JOIN validator_avatar ON validator_avatar.operator_address = validator_info.operator_address
+WHERE validator_avatar.operator_address IS NULL
+   OR COALESCE(validator_avatar.identity, '') <> COALESCE(validator_description.identity, '')
+   OR COALESCE(validator_avatar.website, '') <> COALESCE(validator_description.website, '')`
+
+	return db.getValidatorsDescriptions(stmt)
+}
+
+// getValidatorsDescriptions returns the validator descriptions selected by the given statement
+func (db *Db) getValidatorsDescriptions(stmt string) ([]types.ValidatorDescription, error) {
+	var rows []struct {
+		OperatorAddress string `db:"operator_address"`
+		dbtypes.ValidatorDescriptionRow
+	}
+	err := db.Sqlx.Select(&rows, stmt)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting validators descriptions: %s", err)
+	}
+
+	descriptions := make([]types.ValidatorDescription, len(rows))
+	for i, row := range rows {
+		descriptions[i] = types.NewValidatorDescription(
+			row.OperatorAddress,
+			stakingtypes.NewDescription(
+				row.Moniker.String,
+				row.Identity.String,
+				row.Website.String,
+				row.SecurityContact.String,
+				row.Details.String,
+			),
+			row.AvatarURL.String,
+			row.Height,
+		)
+	}
+
+	return descriptions, nil
+}
+
+// UpdateValidatorAvatarURL sets the given avatar URL inside the description of the validator
+// having the given operator address
+func (db *Db) UpdateValidatorAvatarURL(opAddr string, avatarURL string) error {
+	stmt := `
+UPDATE validator_description
+SET avatar_url = $2
+FROM validator_info
+WHERE validator_info.consensus_address = validator_description.validator_address
+  AND validator_info.operator_address = $1`
+
+	_, err := db.SQL.Exec(stmt, opAddr, dbtypes.ToNullString(avatarURL))
+	if err != nil {
+		return fmt.Errorf("error while updating validator avatar url: %s", err)
+	}
+
+	return nil
+}
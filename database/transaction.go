@@ -59,6 +59,17 @@ func (db *Db) GetTransactions(filter filter.Filter) ([]*txtypes.Tx, error) {
 	return transactions, nil
 }
 
+// GetTransactionsHeights - get the heights, between the given ones, having at least one stored transaction
+func (db *Db) GetTransactionsHeights(startHeight, endHeight int64) ([]int64, error) {
+	var heights []int64
+	stmt := `SELECT DISTINCT height FROM transaction WHERE height BETWEEN $1 AND $2 ORDER BY height`
+	if err := db.Sqlx.Select(&heights, stmt, startHeight, endHeight); err != nil {
+		return nil, errs.Internal{Cause: err.Error()}
+	}
+
+	return heights, nil
+}
+
 // toTxTypesTx - convert database row to Tx
 func (db *Db) toTxTypesTx(tx types.TransactionRow) (*txtypes.Tx, error) {
 	var err error
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"time"
+)
+
+// ActionSnapshotRow represents a single row of the action_snapshot table
+type ActionSnapshotRow struct {
+	Path       string    `db:"path"`
+	RequestKey string    `db:"request_key"`
+	Response   []byte    `db:"response"`
+	Height     int64     `db:"height"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
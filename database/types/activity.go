@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+type (
+	// AddressActivity - db model for 'overgold_address_activity'
+	AddressActivity struct {
+		ID        uint64    `db:"id"`
+		TxHash    string    `db:"tx_hash"`
+		MsgIndex  int       `db:"msg_index"`
+		Height    int64     `db:"height"`
+		Address   string    `db:"address"`
+		Role      string    `db:"role"`
+		Module    string    `db:"module"`
+		MsgType   string    `db:"msg_type"`
+		Timestamp time.Time `db:"timestamp"`
+	}
+)
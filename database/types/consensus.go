@@ -132,3 +132,17 @@ func (b BlockRow) CheckTxNumCount(txs int64) error {
 
 	return nil
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// BlockGapRow represents a single row of the block_gap table
+type BlockGapRow struct {
+	StartHeight     int64          `db:"start_height"`
+	EndHeight       int64          `db:"end_height"`
+	Source          string         `db:"source"`
+	Missing         int64          `db:"missing"`
+	Error           sql.NullString `db:"error"`
+	FirstDetectedAt time.Time      `db:"first_detected_at"`
+	LastCheckedAt   time.Time      `db:"last_checked_at"`
+	FilledAt        sql.NullTime   `db:"filled_at"`
+}
@@ -1,5 +1,7 @@
 package types
 
+import "github.com/lib/pq"
+
 type (
 	// CoreMsgIssue - db model for 'overgold_core_issue'
 	CoreMsgIssue struct {
@@ -23,12 +25,13 @@ type (
 
 	// CoreMsgSend - db model for 'overgold_core_send'
 	CoreMsgSend struct {
-		ID          uint64 `db:"id"`
-		TxHash      string `db:"tx_hash"`
-		Creator     string `db:"creator"`
-		AddressFrom string `db:"address_from"`
-		AddressTo   string `db:"address_to"`
-		Amount      uint64 `db:"amount"`
-		Denom       string `db:"denom"`
+		ID          uint64         `db:"id"`
+		TxHash      string         `db:"tx_hash"`
+		Creator     string         `db:"creator"`
+		AddressFrom string         `db:"address_from"`
+		AddressTo   string         `db:"address_to"`
+		Amount      uint64         `db:"amount"`
+		Denom       string         `db:"denom"`
+		Fee         pq.StringArray `db:"fee"`
 	}
 )
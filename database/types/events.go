@@ -0,0 +1,36 @@
+package types
+
+import "github.com/lib/pq"
+
+type (
+	// EventTransfer - db model for 'overgold_event_transfer'
+	EventTransfer struct {
+		ID         uint64         `db:"id"`
+		TxHash     string         `db:"tx_hash"`
+		MsgIndex   int            `db:"msg_index"`
+		EventIndex int            `db:"event_index"`
+		Sender     string         `db:"sender"`
+		Recipient  string         `db:"recipient"`
+		Amount     pq.StringArray `db:"amount"`
+	}
+
+	// EventFeeCharged - db model for 'overgold_event_fee_charged'
+	EventFeeCharged struct {
+		ID         uint64         `db:"id"`
+		TxHash     string         `db:"tx_hash"`
+		MsgIndex   int            `db:"msg_index"`
+		EventIndex int            `db:"event_index"`
+		Payer      string         `db:"payer"`
+		Amount     pq.StringArray `db:"amount"`
+	}
+
+	// EventRewardPaid - db model for 'overgold_event_reward_paid'
+	EventRewardPaid struct {
+		ID         uint64         `db:"id"`
+		TxHash     string         `db:"tx_hash"`
+		MsgIndex   int            `db:"msg_index"`
+		EventIndex int            `db:"event_index"`
+		Recipient  string         `db:"recipient"`
+		Amount     pq.StringArray `db:"amount"`
+	}
+)
@@ -34,12 +34,16 @@ const (
 	FieldMinAmount       = "min_amount"
 	FieldMinRefBalance   = "min_ref_balance"
 	FieldMsgID           = "msg_id"
+	FieldMsgIndex        = "msg_index"
 	FieldNoRefReward     = "no_ref_reward"
 	FieldNumTxs          = "num_txs"
 	FieldOutputs         = "outputs"
+	FieldPayer           = "payer"
+	FieldRecipient       = "recipient"
 	FieldReferralAddress = "referral_address"
 	FieldReferrerAddress = "referrer_address"
 	FieldRefReward       = "ref_reward"
+	FieldSender          = "sender"
 	FieldStakeReward     = "stake_reward"
 	FieldStatsID         = "stats_id"
 	FieldTariffID        = "tariff_id"
@@ -29,12 +29,14 @@ type TokenPriceRow struct {
 	Price     float64   `db:"price"`
 	MarketCap int64     `db:"market_cap"`
 	Timestamp time.Time `db:"timestamp"`
+	Currency  string    `db:"currency"`
 }
 
 // NewTokenPriceRow allows to easily create a new NewTokenPriceRow
-func NewTokenPriceRow(name string, currentPrice float64, marketCap int64, timestamp time.Time) TokenPriceRow {
+func NewTokenPriceRow(name string, currency string, currentPrice float64, marketCap int64, timestamp time.Time) TokenPriceRow {
 	return TokenPriceRow{
 		Name:      name,
+		Currency:  currency,
 		Price:     currentPrice,
 		MarketCap: marketCap,
 		Timestamp: timestamp,
@@ -44,7 +46,38 @@ func NewTokenPriceRow(name string, currentPrice float64, marketCap int64, timest
 // Equals return true if u and v represent the same row
 func (u TokenPriceRow) Equals(v TokenPriceRow) bool {
 	return u.Name == v.Name &&
+		u.Currency == v.Currency &&
 		u.Price == v.Price &&
 		u.MarketCap == v.MarketCap &&
 		u.Timestamp.Equal(v.Timestamp)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GoldPriceRow represents a single row of the gold_price_history table
+type GoldPriceRow struct {
+	Price     float64   `db:"price"`
+	Timestamp time.Time `db:"timestamp"`
+}
+
+// TokenNAVRow represents a single row of the token_nav table
+type TokenNAVRow struct {
+	UnitName     string    `db:"unit_name"`
+	GramsPerUnit float64   `db:"grams_per_unit"`
+	SpotPrice    float64   `db:"spot_price"`
+	NAV          float64   `db:"nav"`
+	Timestamp    time.Time `db:"timestamp"`
+}
+
+// TokenPriceCandleRow represents a single row of the token_price_candle_hourly and token_price_candle_daily tables
+type TokenPriceCandleRow struct {
+	UnitName  string    `db:"unit_name"`
+	Currency  string    `db:"currency"`
+	Timestamp time.Time `db:"timestamp"`
+	Open      float64   `db:"open"`
+	High      float64   `db:"high"`
+	Low       float64   `db:"low"`
+	Close     float64   `db:"close"`
+	OpenTime  time.Time `db:"open_time"`
+	CloseTime time.Time `db:"close_time"`
+}
@@ -9,4 +9,11 @@ type (
 		ReferrerAddress string `db:"referrer_address"`
 		ReferralAddress string `db:"referral_address"`
 	}
+
+	// DbReferralLink - table for storing the current referrer of each address
+	DbReferralLink struct {
+		ReferralAddress string `db:"referral_address"`
+		ReferrerAddress string `db:"referrer_address"`
+		Height          int64  `db:"height"`
+	}
 )
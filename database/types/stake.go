@@ -1,5 +1,10 @@
 package types
 
+import (
+	"database/sql"
+	"time"
+)
+
 type (
 	// StakeMsgSell - db model for 'overgold_stake_sell'
 	StakeMsgSell struct {
@@ -89,4 +94,127 @@ type (
 		Amount  uint64 `db:"amount"`
 		Kind    string `db:"kind"`
 	}
+
+	// StakeRewardLedger - db model for 'overgold_stake_reward_ledger'
+	StakeRewardLedger struct {
+		ID        uint64    `db:"id"`
+		TxHash    string    `db:"tx_hash"`
+		MsgIndex  int       `db:"msg_index"`
+		Height    int64     `db:"height"`
+		Address   string    `db:"address"`
+		Kind      string    `db:"kind"`
+		Denom     string    `db:"denom"`
+		Amount    string    `db:"amount"`
+		Timestamp time.Time `db:"timestamp"`
+	}
+
+	// StakeRewardBalance - db model for 'overgold_stake_reward_balance'
+	StakeRewardBalance struct {
+		Address string `db:"address"`
+		Denom   string `db:"denom"`
+		Accrued string `db:"accrued"`
+		Claimed string `db:"claimed"`
+		Height  int64  `db:"height"`
+	}
+
+	// StakeSystemAccount - db model for 'overgold_stake_system_account'
+	StakeSystemAccount struct {
+		Address       string        `db:"address"`
+		Kind          string        `db:"kind"`
+		KindHeight    int64         `db:"kind_height"`
+		Active        bool          `db:"active"`
+		CreatedHeight int64         `db:"created_height"`
+		UpdatedHeight int64         `db:"updated_height"`
+		DeletedHeight sql.NullInt64 `db:"deleted_height"`
+	}
+
+	// StakeSystemAccountHistory - db model for 'overgold_stake_system_account_history'
+	StakeSystemAccountHistory struct {
+		ID              uint64    `db:"id"`
+		TxHash          string    `db:"tx_hash"`
+		MsgIndex        int       `db:"msg_index"`
+		Height          int64     `db:"height"`
+		Action          string    `db:"action"`
+		Creator         string    `db:"creator"`
+		Address         string    `db:"address"`
+		PreviousAddress string    `db:"previous_address"`
+		Timestamp       time.Time `db:"timestamp"`
+	}
+
+	// StakeSystemStakeChange - db model for 'overgold_stake_system_stake_change'
+	StakeSystemStakeChange struct {
+		ID       uint64 `db:"id"`
+		TxHash   string `db:"tx_hash"`
+		MsgIndex int    `db:"msg_index"`
+		Height   int64  `db:"height"`
+		Kind     string `db:"kind"`
+		Amount   string `db:"amount"`
+	}
+
+	// StakeSystemStakeTotal - db model for the totals computed from 'overgold_stake_system_stake_change'
+	StakeSystemStakeTotal struct {
+		Kind    string `db:"kind"`
+		Address string `db:"address"`
+		Total   string `db:"total"`
+		Height  int64  `db:"height"`
+	}
+
+	// StakeTransferFlow - db model for 'overgold_stake_transfer_flow'
+	StakeTransferFlow struct {
+		ID           uint64    `db:"id"`
+		TxHash       string    `db:"tx_hash"`
+		MsgIndex     int       `db:"msg_index"`
+		Source       string    `db:"source"`
+		Height       int64     `db:"height"`
+		Date         time.Time `db:"date"`
+		Address      string    `db:"address"`
+		Counterparty string    `db:"counterparty"`
+		Direction    string    `db:"direction"`
+		Amount       string    `db:"amount"`
+	}
+
+	// StakeTransferFlowDaily - db model for 'overgold_stake_transfer_flow_daily'
+	StakeTransferFlowDaily struct {
+		Address   string    `db:"address"`
+		Date      time.Time `db:"date"`
+		AmountIn  string    `db:"amount_in"`
+		AmountOut string    `db:"amount_out"`
+	}
+
+	// StakeTransferFlowCounterparty - db model for 'overgold_stake_transfer_flow_counterparty'
+	StakeTransferFlowCounterparty struct {
+		Address      string `db:"address"`
+		Counterparty string `db:"counterparty"`
+		AmountIn     string `db:"amount_in"`
+		AmountOut    string `db:"amount_out"`
+		Transfers    uint64 `db:"transfers"`
+	}
+
+	// StakeTransferFlowTotal - db model for 'overgold_stake_transfer_flow_total'
+	StakeTransferFlowTotal struct {
+		Address   string `db:"address"`
+		AmountIn  string `db:"amount_in"`
+		AmountOut string `db:"amount_out"`
+		Height    int64  `db:"height"`
+	}
+
+	// StakeBalanceChange - db model for 'overgold_stake_balance_change'
+	StakeBalanceChange struct {
+		ID         uint64 `db:"id"`
+		TxHash     string `db:"tx_hash"`
+		MsgIndex   int    `db:"msg_index"`
+		Height     int64  `db:"height"`
+		Address    string `db:"address"`
+		Amount     string `db:"amount"`
+		SellAmount string `db:"sell_amount"`
+	}
+
+	// StakeBalance - db model for 'overgold_stake_balance'
+	StakeBalance struct {
+		Address         string `db:"address"`
+		Amount          string `db:"amount"`
+		SellAmount      string `db:"sell_amount"`
+		Height          int64  `db:"height"`
+		RefreshedHeight int64  `db:"refreshed_height"`
+	}
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"database/sql"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -334,3 +335,15 @@ func (v DoubleSignEvidenceRow) Equal(w DoubleSignEvidenceRow) bool {
 		v.VoteBID == w.VoteBID &&
 		v.Height == w.Height
 }
+
+//--------------------------------------------------------
+
+// ValidatorAvatarRow represents a single row of the validator_avatar table
+type ValidatorAvatarRow struct {
+	OperatorAddress string         `db:"operator_address"`
+	Identity        sql.NullString `db:"identity"`
+	Website         sql.NullString `db:"website"`
+	AvatarURL       sql.NullString `db:"avatar_url"`
+	Source          sql.NullString `db:"source"`
+	UpdatedAt       time.Time      `db:"updated_at"`
+}
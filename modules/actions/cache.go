@@ -0,0 +1,33 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/cache"
+	actionstypes "github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+// buildCache returns the responses cache based on the given config, or nil if caching is disabled.
+// latestHeight is used to tell which responses refer to a height below the chain tip.
+func buildCache(cfg *CacheConfig, latestHeight func() (int64, error)) (*actionstypes.ResponseCache, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+
+	var backend actionstypes.CacheBackend
+	switch cfg.Backend {
+	case CacheBackendMemory:
+		backend = cache.NewLRU(cfg.Size)
+
+	case CacheBackendRedis:
+		if cfg.Redis == nil || cfg.Redis.Address == "" {
+			return nil, fmt.Errorf("missing redis address for actions cache")
+		}
+		backend = cache.NewRedis(cfg.Redis.Address, cfg.Redis.Password, cfg.Redis.DB, cfg.Redis.Prefix)
+
+	default:
+		return nil, fmt.Errorf("invalid actions cache backend: %s", cfg.Backend)
+	}
+
+	return actionstypes.NewResponseCache(backend, cfg.LatestTTL, cfg.Exclude, latestHeight), nil
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	actionstypes "github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+var (
+	_ actionstypes.CacheBackend = &LRU{}
+)
+
+// LRU represents an in-process cache that evicts the least recently used entries once full
+type LRU struct {
+	mu      sync.Mutex
+	size    int
+	entries *list.List
+	items   map[string]*list.Element
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// expired tells whether the entry has expired at the given time
+func (e *lruEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+// NewLRU returns a new LRU instance holding at most size entries
+func NewLRU(size int) *LRU {
+	return &LRU{
+		size:    size,
+		entries: list.New(),
+		items:   make(map[string]*list.Element),
+	}
+}
+
+// Get implements actionstypes.CacheBackend
+func (c *LRU) Get(key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := element.Value.(*lruEntry)
+	if entry.expired(time.Now()) {
+		c.remove(element)
+		return nil, false, nil
+	}
+
+	c.entries.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+// Set implements actionstypes.CacheBackend
+func (c *LRU) Set(key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if element, ok := c.items[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.entries.MoveToFront(element)
+		return nil
+	}
+
+	c.items[key] = c.entries.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.size > 0 && c.entries.Len() > c.size {
+		c.remove(c.entries.Back())
+	}
+
+	return nil
+}
+
+// remove removes the given element from the cache
+func (c *LRU) remove(element *list.Element) {
+	c.entries.Remove(element)
+	delete(c.items, element.Value.(*lruEntry).key)
+}
@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU_GetSet(t *testing.T) {
+	cache := NewLRU(10)
+
+	_, found, err := cache.Get("key")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, cache.Set("key", []byte("value"), 0))
+	value, found, err := cache.Get("key")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("value"), value)
+
+	require.NoError(t, cache.Set("key", []byte("updated"), 0))
+	value, _, _ = cache.Get("key")
+	require.Equal(t, []byte("updated"), value)
+}
+
+func TestLRU_Expiration(t *testing.T) {
+	cache := NewLRU(10)
+
+	require.NoError(t, cache.Set("expiring", []byte("value"), time.Millisecond))
+	require.NoError(t, cache.Set("permanent", []byte("value"), 0))
+	time.Sleep(5 * time.Millisecond)
+
+	_, found, _ := cache.Get("expiring")
+	require.False(t, found)
+
+	_, found, _ = cache.Get("permanent")
+	require.True(t, found)
+}
+
+func TestLRU_Eviction(t *testing.T) {
+	cache := NewLRU(2)
+
+	require.NoError(t, cache.Set("first", []byte("1"), 0))
+	require.NoError(t, cache.Set("second", []byte("2"), 0))
+
+	// Reading the first entry makes the second one the least recently used
+	_, found, _ := cache.Get("first")
+	require.True(t, found)
+
+	require.NoError(t, cache.Set("third", []byte("3"), 0))
+
+	_, found, _ = cache.Get("second")
+	require.False(t, found)
+
+	_, found, _ = cache.Get("first")
+	require.True(t, found)
+
+	_, found, _ = cache.Get("third")
+	require.True(t, found)
+}
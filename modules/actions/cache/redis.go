@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+
+	actionstypes "github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+var (
+	_ actionstypes.CacheBackend = &Redis{}
+)
+
+const (
+	// redisTimeout is the deadline applied to the connection, read and write of each cache command,
+	// so that a slow server does not hold the actions requests
+	redisTimeout = time.Second
+)
+
+// Redis represents a cache stored inside any server speaking the Redis protocol.
+// Entries are stored under the given prefix, and evictions are left to the server policy.
+type Redis struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedis returns a new Redis instance connecting to the given address
+func NewRedis(address, password string, db int, prefix string) *Redis {
+	return &Redis{
+		client: redis.NewClient(&redis.Options{
+			Addr:         address,
+			Password:     password,
+			DB:           db,
+			DialTimeout:  redisTimeout,
+			ReadTimeout:  redisTimeout,
+			WriteTimeout: redisTimeout,
+			PoolTimeout:  redisTimeout,
+		}),
+		prefix: prefix,
+	}
+}
+
+// Get implements actionstypes.CacheBackend
+func (r *Redis) Get(key string) ([]byte, bool, error) {
+	res, err := r.client.Get(r.prefix + key).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+	return res, true, nil
+}
+
+// Set implements actionstypes.CacheBackend
+func (r *Redis) Set(key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(r.prefix+key, value, ttl).Err()
+}
@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis is a minimal in-process server speaking the subset of the Redis protocol used by the cache
+type fakeRedis struct {
+	listener net.Listener
+
+	mu      sync.Mutex
+	entries map[string]string
+	ttls    map[string]time.Duration
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &fakeRedis{listener: listener, entries: map[string]string{}, ttls: map[string]time.Duration{}}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+
+	return server
+}
+
+func (s *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		_, err = io.WriteString(conn, s.handle(args))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeRedis) handle(args []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "GET":
+		value, ok := s.entries[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+
+	case "SET":
+		s.entries[args[1]] = args[2]
+		s.ttls[args[1]] = 0
+		if len(args) == 5 && strings.ToUpper(args[3]) == "PX" {
+			ms, _ := strconv.Atoi(args[4])
+			s.ttls[args[1]] = time.Duration(ms) * time.Millisecond
+		} else if len(args) == 5 && strings.ToUpper(args[3]) == "EX" {
+			seconds, _ := strconv.Atoi(args[4])
+			s.ttls[args[1]] = time.Duration(seconds) * time.Second
+		}
+		return "+OK\r\n"
+
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+// readCommand reads a single command sent as an array of bulk strings
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, count)
+	for i := range args {
+		line, err = reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "$")))
+		if err != nil {
+			return nil, err
+		}
+
+		bz := make([]byte, length+2)
+		_, err = io.ReadFull(reader, bz)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = string(bz[:length])
+	}
+
+	return args, nil
+}
+
+func TestRedis_GetSet(t *testing.T) {
+	server := newFakeRedis(t)
+	cache := NewRedis(server.listener.Addr().String(), "", 0, "bdjuno:")
+
+	_, found, err := cache.Get("key")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, cache.Set("key", []byte("value"), 0))
+	value, found, err := cache.Get("key")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("value"), value)
+
+	require.NoError(t, cache.Set("expiring", []byte("value"), 5*time.Second))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Equal(t, "value", server.entries["bdjuno:key"])
+	require.Equal(t, time.Duration(0), server.ttls["bdjuno:key"])
+	require.Equal(t, 5*time.Second, server.ttls["bdjuno:expiring"])
+}
+
+func TestRedis_Unavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	cache := NewRedis(address, "", 0, "")
+
+	_, found, err := cache.Get("key")
+	require.Error(t, err)
+	require.False(t, found)
+
+	require.Error(t, cache.Set("key", []byte("value"), 0))
+}
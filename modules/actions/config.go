@@ -1,8 +1,13 @@
 package actions
 
 import (
+	"time"
+
 	"github.com/forbole/juno/v5/node/remote"
 	"gopkg.in/yaml.v3"
+
+	actionstypes "github.com/forbole/bdjuno/v4/modules/actions/types"
+	"github.com/forbole/bdjuno/v4/modules/nodepool"
 )
 
 // Config contains the configuration about the actions module
@@ -10,23 +15,108 @@ type Config struct {
 	Host string          `yaml:"host"`
 	Port uint            `yaml:"port"`
 	Node *remote.Details `yaml:"node,omitempty"`
+
+	// NodePool, when set, takes precedence over Node
+	NodePool *nodepool.Config `yaml:"node_pool,omitempty"`
+
+	Auth            *AuthConfig      `yaml:"auth,omitempty"`
+	RateLimit       *RateLimitConfig `yaml:"rate_limit,omitempty"`
+	MaxBodySize     int64            `yaml:"max_body_size,omitempty"`
+	RequestTimeout  time.Duration    `yaml:"request_timeout,omitempty"`
+	ShutdownTimeout time.Duration    `yaml:"shutdown_timeout,omitempty"`
+	Cache           *CacheConfig     `yaml:"cache,omitempty"`
+	Batch           *BatchConfig     `yaml:"batch,omitempty"`
+	REST            *RESTConfig      `yaml:"rest,omitempty"`
+	DBFallback      []string         `yaml:"db_fallback,omitempty"`
+}
+
+// AuthConfig contains the configuration used to verify that requests are coming from Hasura.
+// When Secret is empty no verification is performed.
+type AuthConfig struct {
+	Header string `yaml:"header,omitempty"`
+	Secret string `yaml:"secret"`
+}
+
+// RateLimitConfig contains the token-bucket limits applied to the incoming requests
+type RateLimitConfig struct {
+	PerIP             *LimitConfig           `yaml:"per_ip,omitempty"`
+	PerAction         map[string]LimitConfig `yaml:"per_action,omitempty"`
+	TrustForwardedFor bool                   `yaml:"trust_forwarded_for,omitempty"`
+}
+
+// LimitConfig contains the parameters of a single token bucket
+type LimitConfig struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// RESTConfig contains the configuration about the REST front-end of the actions
+type RESTConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Prefix  string `yaml:"prefix,omitempty"`
+}
+
+// BatchConfig contains the limits applied to the batch variants of the actions
+type BatchConfig struct {
+	MaxAddresses int `yaml:"max_addresses,omitempty"`
+	Concurrency  int `yaml:"concurrency,omitempty"`
+}
+
+// CacheConfig contains the configuration about the actions responses cache
+type CacheConfig struct {
+	Enabled   bool          `yaml:"enabled"`
+	Backend   string        `yaml:"backend,omitempty"`
+	Size      int           `yaml:"size,omitempty"`
+	LatestTTL time.Duration `yaml:"latest_ttl,omitempty"`
+	Exclude   []string      `yaml:"exclude,omitempty"`
+	Redis     *RedisConfig  `yaml:"redis,omitempty"`
+}
+
+// RedisConfig contains the configuration used to connect to a Redis-compatible cache server
+type RedisConfig struct {
+	Address  string `yaml:"address"`
+	Password string `yaml:"password,omitempty"`
+	DB       int    `yaml:"db,omitempty"`
+	Prefix   string `yaml:"prefix,omitempty"`
 }
 
+const (
+	CacheBackendMemory = "memory"
+	CacheBackendRedis  = "redis"
+)
+
+const (
+	DefaultAuthHeader      = "X-Actions-Secret"
+	DefaultMaxBodySize     = 1 << 20
+	DefaultRequestTimeout  = 30 * time.Second
+	DefaultShutdownTimeout = 10 * time.Second
+	DefaultRESTPrefix      = "/api/v1"
+	DefaultCacheSize       = 10000
+	DefaultCacheLatestTTL  = 5 * time.Second
+	DefaultCachePrefix     = "bdjuno:actions:"
+)
+
 // NewConfig returns a new Config instance
 func NewConfig(host string, port uint, remoteDetails *remote.Details) *Config {
 	return &Config{
-		Host: host,
-		Port: port,
-		Node: remoteDetails,
+		Host:            host,
+		Port:            port,
+		Node:            remoteDetails,
+		MaxBodySize:     DefaultMaxBodySize,
+		RequestTimeout:  DefaultRequestTimeout,
+		ShutdownTimeout: DefaultShutdownTimeout,
 	}
 }
 
 // DefaultConfig returns the default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		Host: "127.0.0.1",
-		Port: 3000,
-		Node: nil,
+		Host:            "127.0.0.1",
+		Port:            3000,
+		Node:            nil,
+		MaxBodySize:     DefaultMaxBodySize,
+		RequestTimeout:  DefaultRequestTimeout,
+		ShutdownTimeout: DefaultShutdownTimeout,
 	}
 }
 
@@ -41,5 +131,49 @@ func ParseConfig(bz []byte) (*Config, error) {
 		return DefaultConfig(), nil
 	}
 
+	if cfg.Config.MaxBodySize == 0 {
+		cfg.Config.MaxBodySize = DefaultMaxBodySize
+	}
+
+	if cfg.Config.RequestTimeout == 0 {
+		cfg.Config.RequestTimeout = DefaultRequestTimeout
+	}
+
+	if cfg.Config.ShutdownTimeout == 0 {
+		cfg.Config.ShutdownTimeout = DefaultShutdownTimeout
+	}
+
+	if cfg.Config.Auth != nil && cfg.Config.Auth.Header == "" {
+		cfg.Config.Auth.Header = DefaultAuthHeader
+	}
+
+	if cfg.Config.REST != nil && cfg.Config.REST.Prefix == "" {
+		cfg.Config.REST.Prefix = DefaultRESTPrefix
+	}
+
+	if batch := cfg.Config.Batch; batch != nil {
+		if batch.MaxAddresses == 0 {
+			batch.MaxAddresses = actionstypes.DefaultBatchMaxAddresses
+		}
+		if batch.Concurrency == 0 {
+			batch.Concurrency = actionstypes.DefaultBatchConcurrency
+		}
+	}
+
+	if cache := cfg.Config.Cache; cache != nil {
+		if cache.Backend == "" {
+			cache.Backend = CacheBackendMemory
+		}
+		if cache.Size == 0 {
+			cache.Size = DefaultCacheSize
+		}
+		if cache.LatestTTL == 0 {
+			cache.LatestTTL = DefaultCacheLatestTTL
+		}
+		if cache.Redis != nil && cache.Redis.Prefix == "" {
+			cache.Redis.Prefix = DefaultCachePrefix
+		}
+	}
+
 	return cfg.Config, err
 }
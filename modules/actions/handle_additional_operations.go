@@ -1,68 +1,163 @@
 package actions
 
 import (
-	"os"
+	"context"
 	"os/signal"
-	"sync"
 	"syscall"
 
+	"github.com/rs/zerolog/log"
+
 	"github.com/forbole/bdjuno/v4/modules/actions/handlers"
 	actionstypes "github.com/forbole/bdjuno/v4/modules/actions/types"
 )
 
-var (
-	waitGroup sync.WaitGroup
-)
-
 func (m *Module) RunAdditionalOperations() error {
 	// Build the worker
-	context := actionstypes.NewContext(m.node, m.sources)
-	worker := actionstypes.NewActionsWorker(context)
+	actionsCtx := actionstypes.NewContext(m.node, m.sources, m.db)
+	worker := actionstypes.NewActionsWorker(actionsCtx)
+	worker.Use(buildMiddlewares(m.cfg)...)
+
+	cache, err := buildCache(m.cfg.Cache, m.node.LatestHeight)
+	if err != nil {
+		return err
+	}
+	worker.SetCache(cache)
+
+	if m.cfg.Batch != nil {
+		worker.SetBatchLimits(m.cfg.Batch.MaxAddresses, m.cfg.Batch.Concurrency)
+	}
 
-	// Register the endpoints
+	if m.cfg.REST != nil && m.cfg.REST.Enabled {
+		worker.EnableREST(m.cfg.REST.Prefix)
+	}
+
+	worker.EnableFallback(m.cfg.DBFallback...)
+
+	// Register the endpoints.
+	// Handlers reading the data from the node at the requested height are immutable,
+	// while the ones reading the indexed data or always using the latest height are not.
+	// Batch variants are registered for the handlers keyed by address
+	immutable, batch := actionstypes.Immutable(), actionstypes.Batch()
+
+	address := actionstypes.Args(actionstypes.ArgsSpec{
+		Required: []string{"address"},
+	})
+	addressAtHeight := actionstypes.Args(actionstypes.ArgsSpec{
+		Required: []string{"address"},
+		Optional: []string{"height"},
+	})
+	addressPageAtHeight := actionstypes.Args(actionstypes.ArgsSpec{
+		Required: []string{"address"},
+		Optional: []string{"height", "offset", "limit", "count_total", "cursor"},
+	})
 
 	// -- Bank --
-	worker.RegisterHandler("/account_balance", handlers.AccountBalanceHandler)
+	worker.RegisterHandlerWithSnapshot("/account_balance", handlers.AccountBalanceHandler,
+		immutable, batch, addressAtHeight, actionstypes.Response(actionstypes.Balance{}))
 
 	// -- Distribution --
-	worker.RegisterHandler("/delegation_reward", handlers.DelegationRewardHandler)
-	worker.RegisterHandler("/delegator_withdraw_address", handlers.DelegatorWithdrawAddressHandler)
-	worker.RegisterHandler("/validator_commission_amount", handlers.ValidatorCommissionAmountHandler)
+	worker.RegisterHandler("/delegation_reward", handlers.DelegationRewardHandler,
+		immutable, batch, addressAtHeight, actionstypes.Response([]actionstypes.DelegationReward{}))
+	worker.RegisterHandler("/delegator_withdraw_address", handlers.DelegatorWithdrawAddressHandler,
+		batch, address, actionstypes.Response(actionstypes.Address{}))
+	worker.RegisterHandlerWithSnapshot("/validator_commission_amount", handlers.ValidatorCommissionAmountHandler,
+		batch, address, actionstypes.Response(actionstypes.ValidatorCommissionAmount{}))
 
 	// -- Staking Delegator --
-	worker.RegisterHandler("/delegation", handlers.DelegationHandler)
-	worker.RegisterHandler("/delegation_total", handlers.TotalDelegationAmountHandler)
-	worker.RegisterHandler("/unbonding_delegation", handlers.UnbondingDelegationsHandler)
-	worker.RegisterHandler("/unbonding_delegation_total", handlers.UnbondingDelegationsTotal)
-	worker.RegisterHandler("/redelegation", handlers.RedelegationHandler)
+	worker.RegisterHandler("/delegation", handlers.DelegationHandler,
+		immutable, batch, addressPageAtHeight, actionstypes.Response(actionstypes.DelegationResponse{}))
+	worker.RegisterHandler("/delegation_total", handlers.TotalDelegationAmountHandler,
+		immutable, batch, addressAtHeight, actionstypes.Response(actionstypes.Balance{}))
+	worker.RegisterHandler("/unbonding_delegation", handlers.UnbondingDelegationsHandler,
+		immutable, batch, addressPageAtHeight, actionstypes.Response(actionstypes.UnbondingDelegationResponse{}))
+	worker.RegisterHandler("/unbonding_delegation_total", handlers.UnbondingDelegationsTotal,
+		immutable, batch, addressAtHeight, actionstypes.Response(actionstypes.Balance{}))
+	worker.RegisterHandler("/redelegation", handlers.RedelegationHandler,
+		immutable, batch, addressPageAtHeight, actionstypes.Response(actionstypes.RedelegationResponse{}))
 
 	// -- Staking Validator --
-	worker.RegisterHandler("/validator_delegations", handlers.ValidatorDelegation)
-	worker.RegisterHandler("/validator_redelegations_from", handlers.ValidatorRedelegationsFromHandler)
-	worker.RegisterHandler("/validator_unbonding_delegations", handlers.ValidatorUnbondingDelegationsHandler)
+	worker.RegisterHandlerWithSnapshot("/validator_delegations", handlers.ValidatorDelegation,
+		immutable, batch, addressPageAtHeight, actionstypes.Response(actionstypes.DelegationResponse{}))
+	worker.RegisterHandlerWithSnapshot(
+		"/validator_redelegations_from", handlers.ValidatorRedelegationsFromHandler,
+		immutable, batch, addressPageAtHeight, actionstypes.Response(actionstypes.RedelegationResponse{}))
+	worker.RegisterHandlerWithSnapshot(
+		"/validator_unbonding_delegations", handlers.ValidatorUnbondingDelegationsHandler,
+		immutable, batch, addressPageAtHeight, actionstypes.Response(actionstypes.UnbondingDelegationResponse{}))
+
+	// -- OverGold --
+	worker.RegisterHandlerWithSnapshot("/ovg_stakes", handlers.OvgStakesHandler,
+		immutable, batch, addressAtHeight, actionstypes.Response(actionstypes.OvgStakesResponse{}))
+	worker.RegisterHandlerWithFallback(
+		"/ovg_fees", handlers.OvgFeesHandler, handlers.OvgFeesFallbackHandler,
+		immutable, actionstypes.Args(actionstypes.ArgsSpec{
+			Optional: []string{"denom", "height"},
+		}), actionstypes.Response(actionstypes.OvgFeesResponse{}))
+	worker.RegisterHandler("/ovg_fee_quote", handlers.OvgFeeQuoteHandler,
+		actionstypes.Args(actionstypes.ArgsSpec{
+			Required: []string{"sender", "receiver", "amount", "denom"},
+			Optional: []string{"height"},
+		}), actionstypes.Response(actionstypes.OvgFeeQuoteResponse{}))
+	worker.RegisterHandlerWithFallback(
+		"/ovg_referrer", handlers.OvgReferrerHandler, handlers.OvgReferrerFallbackHandler,
+		immutable, batch, addressAtHeight, actionstypes.Response(actionstypes.OvgReferrerResponse{}))
+	worker.RegisterHandlerWithFallback(
+		"/ovg_is_allowed", handlers.OvgIsAllowedHandler, handlers.OvgIsAllowedFallbackHandler,
+		immutable, batch, addressAtHeight, actionstypes.Response(actionstypes.OvgIsAllowedResponse{}))
+
+	// -- OverGold Activity --
+	worker.RegisterHandler("/address_activity", handlers.AddressActivityHandler,
+		batch, actionstypes.Args(actionstypes.ArgsSpec{
+			Required: []string{"address"},
+			Optional: []string{"msg_types", "roles", "offset", "limit", "count_total"},
+		}), actionstypes.Response(actionstypes.AddressActivityResponse{}))
+
+	// -- OverGold Stake --
+	worker.RegisterHandler("/stake_pending_rewards", handlers.StakePendingRewardsHandler,
+		batch, address, actionstypes.Response(actionstypes.Balance{}))
+	worker.RegisterHandler("/stake_reward_history", handlers.StakeRewardHistoryHandler,
+		batch, actionstypes.Args(actionstypes.ArgsSpec{
+			Required: []string{"address"},
+			Optional: []string{"offset", "limit"},
+		}), actionstypes.Response(actionstypes.StakeRewardHistoryResponse{}))
+	worker.RegisterHandler("/system_stake", handlers.SystemStakeHandler,
+		actionstypes.Args(actionstypes.ArgsSpec{
+			Optional: []string{"height"},
+		}), actionstypes.Response(actionstypes.SystemStakeResponse{}))
+	worker.RegisterHandler("/stake_transfer_flows", handlers.StakeTransferFlowsHandler,
+		batch, actionstypes.Args(actionstypes.ArgsSpec{
+			Required: []string{"address"},
+			Optional: []string{"days"},
+		}), actionstypes.Response(actionstypes.StakeTransferFlowsResponse{}))
 
 	// Listen for and trap any OS signal to gracefully shutdown and exit
-	m.trapSignal()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	// Start the worker
-	waitGroup.Add(1)
-	go worker.Start(m.cfg.Host, m.cfg.Port)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- worker.Start(m.cfg.Host, m.cfg.Port)
+	}()
 
-	// Block main process (signal capture will call WaitGroup's Done)
-	waitGroup.Wait()
-	return nil
-}
+	// Block until the worker fails or a signal is received
+	select {
+	case err = <-errCh:
+		m.node.Stop()
+		return err
 
-// trapSignal will listen for any OS signal and invoke Done on the main
-// WaitGroup allowing the main process to gracefully exit.
-func (m *Module) trapSignal() {
-	var sigCh = make(chan os.Signal, 1)
+	case <-ctx.Done():
+		log.Info().Msg("shutting down actions worker")
+	}
 
-	signal.Notify(sigCh, syscall.SIGTERM)
-	signal.Notify(sigCh, syscall.SIGINT)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), m.cfg.ShutdownTimeout)
+	defer cancel()
 
-	go func() {
-		defer m.node.Stop()
-		defer waitGroup.Done()
-	}()
+	err = worker.Shutdown(shutdownCtx)
+	m.node.Stop()
+	if err != nil {
+		return err
+	}
+
+	return <-errCh
 }
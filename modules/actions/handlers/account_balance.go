@@ -24,6 +24,7 @@ func AccountBalanceHandler(ctx *types.Context, payload *types.Payload) (interfac
 	}
 
 	return types.Balance{
-		Coins: types.ConvertCoins(balance),
+		DataSource: types.NewDataSource(types.SourceNode, height),
+		Coins:      types.ConvertCoins(balance),
 	}, nil
 }
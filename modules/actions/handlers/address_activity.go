@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/activity"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
+)
+
+// defaultAddressActivityLimit is used when no limit is provided with the request
+const defaultAddressActivityLimit = 100
+
+func AddressActivityHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Strs("msg_types", payload.Input.MsgTypes).
+		Strs("roles", payload.Input.Roles).
+		Msg("executing address activity action")
+
+	limit := payload.Input.Limit
+	if limit == 0 {
+		limit = defaultAddressActivityLimit
+	}
+
+	activities, total, err := activity.NewRepository(ctx.Db.Sqlx).GetAddressActivities(bdtypes.AddressActivityFilter{
+		Address:  payload.GetAddress(),
+		MsgTypes: payload.Input.MsgTypes,
+		Roles:    payload.Input.Roles,
+		Offset:   payload.Input.Offset,
+		Limit:    limit,
+	})
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting address activity: %s", err)
+	}
+
+	activitiesList := make([]types.AddressActivity, len(activities))
+	for index, a := range activities {
+		activitiesList[index] = types.AddressActivity{
+			TxHash:    a.TxHash,
+			MsgIndex:  a.MsgIndex,
+			Height:    a.Height,
+			Role:      a.Role,
+			Module:    a.Module,
+			MsgType:   a.MsgType,
+			Timestamp: a.Timestamp,
+		}
+	}
+
+	pagination := &query.PageResponse{}
+	if payload.Input.CountTotal {
+		pagination.Total = total
+	}
+
+	return types.AddressActivityResponse{
+		Activities: activitiesList,
+		Pagination: pagination,
+	}, nil
+}
@@ -22,8 +22,13 @@ func DelegationHandler(ctx *types.Context, payload *types.Payload) (interface{},
 		return nil, err
 	}
 
+	pagination, err := payload.GetPagination()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get delegator's total rewards
-	res, err := ctx.Sources.StakingSource.GetDelegationsWithPagination(height, payload.GetAddress(), payload.GetPagination())
+	res, err := ctx.Sources.StakingSource.GetDelegationsWithPagination(height, payload.GetAddress(), pagination)
 	if err != nil {
 		// For stargate only, returns without throwing error if delegator delegations are not found on the chain
 		if strings.Contains(err.Error(), codes.NotFound.String()) {
@@ -43,6 +48,6 @@ func DelegationHandler(ctx *types.Context, payload *types.Payload) (interface{},
 
 	return types.DelegationResponse{
 		Delegations: delegations,
-		Pagination:  res.Pagination,
+		Pagination:  types.NewPagination(res.Pagination, height),
 	}, nil
 }
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/feeexcluder"
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+	feequote "github.com/forbole/bdjuno/v4/modules/overgold/chain/feeexcluder"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+// feeQuoteStore provides the indexed fee excluder data used to compute a fee quote
+type feeQuoteStore interface {
+	GetAllAddress(f filter.Filter) ([]fe.Address, error)
+	GetAllTariffs(f filter.Filter) ([]fe.Tariffs, error)
+}
+
+func OvgFeeQuoteHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("sender", payload.Input.Sender).
+		Str("receiver", payload.Input.Receiver).
+		Str("denom", payload.Input.Denom).
+		Str("amount", payload.Input.Amount).
+		Msg("executing overgold fee quote action")
+
+	amount, ok := sdkmath.NewIntFromString(payload.Input.Amount)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %s", payload.Input.Amount)
+	}
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := feeexcluder.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc)
+	return feeQuote(repo, ctx.Sources, payload, amount, height)
+}
+
+// feeQuote computes the fee breakdown of the transfer described by the given payload at the given height
+func feeQuote(
+	store feeQuoteStore, sources *modulestypes.Sources, payload *types.Payload, amount sdkmath.Int, height int64,
+) (types.OvgFeeQuoteResponse, error) {
+	req := feequote.QuoteRequest{
+		Denom:  payload.Input.Denom,
+		Amount: amount,
+	}
+
+	// Transfers from or to an excluded address do not pay any fee
+	_, err := store.GetAllAddress(filter.NewFilter().
+		SetArgument(dbtypes.FieldAddress, []string{payload.Input.Sender, payload.Input.Receiver}))
+	switch {
+	case err == nil:
+		req.Excluded = true
+	case !errors.As(err, &errs.NotFound{}):
+		return types.OvgFeeQuoteResponse{}, fmt.Errorf("error while getting fee excluded addresses: %s", err)
+	}
+
+	tariffs, err := quoteTariffs(store, sources, payload.Input.Denom, height)
+	if err != nil {
+		return types.OvgFeeQuoteResponse{}, err
+	}
+
+	if sources.OverGoldReferralSource != nil {
+		req.Referrer, err = sources.OverGoldReferralSource.GetReferrer(payload.Input.Sender, height)
+		if err != nil {
+			return types.OvgFeeQuoteResponse{}, fmt.Errorf("error while getting overgold referrer: %s", err)
+		}
+	}
+
+	if req.Referrer != "" {
+		req.ReferrerBalance, err = sources.BankSource.GetAccountBalance(req.Referrer, height)
+		if err != nil {
+			return types.OvgFeeQuoteResponse{}, fmt.Errorf("error while getting referrer balance: %s", err)
+		}
+	}
+
+	quote, err := feequote.Quote(tariffs, req)
+	if err != nil {
+		return types.OvgFeeQuoteResponse{}, fmt.Errorf("error while computing fee quote: %s", err)
+	}
+
+	return types.OvgFeeQuoteResponse{
+		Sender:       payload.Input.Sender,
+		Receiver:     payload.Input.Receiver,
+		Denom:        quote.Denom,
+		Amount:       quote.Amount,
+		Excluded:     quote.Excluded,
+		TariffID:     quote.TariffID,
+		FeesID:       quote.FeesID,
+		Referrer:     req.Referrer,
+		Fee:          quote.Fee,
+		RefReward:    quote.RefReward,
+		StakeReward:  quote.StakeReward,
+		SystemReward: quote.SystemReward,
+		Total:        quote.Total(),
+	}, nil
+}
+
+// quoteTariffs returns the tariffs of the given denom from the indexed tariff tables,
+// falling back to the tariffs returned by the fee excluder source when none has been indexed
+func quoteTariffs(
+	store feeQuoteStore, sources *modulestypes.Sources, denom string, height int64,
+) ([]*fe.Tariff, error) {
+	tariffs, err := store.GetAllTariffs(filter.NewFilter().SetArgument(dbtypes.FieldDenom, denom))
+	if err == nil {
+		var res []*fe.Tariff
+		for _, t := range tariffs {
+			res = append(res, t.Tariffs...)
+		}
+
+		return res, nil
+	}
+
+	if !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting fee excluder tariffs: %s", err)
+	}
+
+	if sources.OverGoldFeeExcluderSource == nil {
+		return nil, nil
+	}
+
+	nodeTariffs, err := sources.OverGoldFeeExcluderSource.GetTariffs([]string{denom}, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting overgold tariffs: %s", err)
+	}
+
+	var res []*fe.Tariff
+	for _, t := range nodeTariffs {
+		res = append(res, t.Tariffs...)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	referraltypes "git.ooo.ua/vipcoin/ovg-chain/x/referral/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+	banksource "github.com/forbole/bdjuno/v4/modules/bank/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+const (
+	quoteDenom    = "ovg"
+	quoteSender   = "ovg1sender"
+	quoteReceiver = "ovg1receiver"
+	quoteReferrer = "ovg1referrer"
+)
+
+// fakeFeeQuoteStore returns the same excluded addresses and tariffs regardless of the filter
+type fakeFeeQuoteStore struct {
+	excluded []fe.Address
+	tariffs  []fe.Tariffs
+}
+
+func (s fakeFeeQuoteStore) GetAllAddress(_ filter.Filter) ([]fe.Address, error) {
+	if len(s.excluded) == 0 {
+		return nil, errs.NotFound{What: "overgold_feeexcluder_address"}
+	}
+	return s.excluded, nil
+}
+
+func (s fakeFeeQuoteStore) GetAllTariffs(_ filter.Filter) ([]fe.Tariffs, error) {
+	if len(s.tariffs) == 0 {
+		return nil, errs.NotFound{What: "overgold_feeexcluder_tariffs"}
+	}
+	return s.tariffs, nil
+}
+
+// fakeReferralSource returns the referrers of the addresses it contains
+type fakeReferralSource struct {
+	referrers map[string]string
+}
+
+func (s fakeReferralSource) GetStats(_ []string, _ int64) ([]*referraltypes.Stats, error) {
+	return nil, nil
+}
+
+func (s fakeReferralSource) GetReferrer(address string, _ int64) (string, error) {
+	return s.referrers[address], nil
+}
+
+// fakeBankSource returns the balances of the addresses it contains
+type fakeBankSource struct {
+	banksource.Source
+	balances map[string]sdk.Coins
+}
+
+func (s fakeBankSource) GetAccountBalance(address string, _ int64) ([]sdk.Coin, error) {
+	return s.balances[address], nil
+}
+
+func TestFeeQuote(t *testing.T) {
+	store := fakeFeeQuoteStore{
+		tariffs: []fe.Tariffs{{
+			Denom: quoteDenom,
+			Tariffs: []*fe.Tariff{{
+				Id:            1,
+				Amount:        "0",
+				Denom:         quoteDenom,
+				MinRefBalance: "1000",
+				Fees: []*fe.Fees{
+					{Id: 1, AmountFrom: "0", Fee: "0.1", RefReward: "0.5", StakeReward: "0.2"},
+				},
+			}},
+		}},
+	}
+
+	testCases := []struct {
+		name      string
+		referrers map[string]string
+		balances  map[string]sdk.Coins
+		referrer  string
+		ref       int64
+		system    int64
+	}{
+		{
+			name:      "referred sender rewards the referrer",
+			referrers: map[string]string{quoteSender: quoteReferrer},
+			balances:  map[string]sdk.Coins{quoteReferrer: sdk.NewCoins(sdk.NewInt64Coin(quoteDenom, 1000))},
+			referrer:  quoteReferrer,
+			ref:       50,
+			system:    30,
+		},
+		{
+			name:      "referrer below the min ref balance is not rewarded",
+			referrers: map[string]string{quoteSender: quoteReferrer},
+			balances:  map[string]sdk.Coins{quoteReferrer: sdk.NewCoins(sdk.NewInt64Coin(quoteDenom, 999))},
+			referrer:  quoteReferrer,
+			ref:       0,
+			system:    80,
+		},
+		{
+			name:   "sender without referrer",
+			ref:    0,
+			system: 80,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sources := &modulestypes.Sources{
+				BankSource:             fakeBankSource{balances: tc.balances},
+				OverGoldReferralSource: fakeReferralSource{referrers: tc.referrers},
+			}
+			payload := &types.Payload{Input: types.PayloadArgs{
+				Sender:   quoteSender,
+				Receiver: quoteReceiver,
+				Denom:    quoteDenom,
+				Amount:   "1000",
+			}}
+
+			res, err := feeQuote(store, sources, payload, sdkmath.NewInt(1000), 10)
+			require.NoError(t, err)
+			require.Equal(t, tc.referrer, res.Referrer)
+			require.Equal(t, sdkmath.NewInt(100), res.Fee)
+			require.Equal(t, sdkmath.NewInt(tc.ref), res.RefReward)
+			require.Equal(t, sdkmath.NewInt(20), res.StakeReward)
+			require.Equal(t, sdkmath.NewInt(tc.system), res.SystemReward)
+		})
+	}
+}
+
+func TestFeeQuote_Excluded(t *testing.T) {
+	store := fakeFeeQuoteStore{excluded: []fe.Address{{Address: quoteSender}}}
+	sources := &modulestypes.Sources{
+		OverGoldReferralSource: fakeReferralSource{referrers: map[string]string{quoteSender: quoteReferrer}},
+		BankSource:             fakeBankSource{},
+	}
+	payload := &types.Payload{Input: types.PayloadArgs{Sender: quoteSender, Receiver: quoteReceiver, Denom: quoteDenom}}
+
+	res, err := feeQuote(store, sources, payload, sdkmath.NewInt(1000), 10)
+	require.NoError(t, err)
+	require.True(t, res.Excluded)
+	require.True(t, res.Fee.IsZero())
+	require.True(t, res.RefReward.IsZero())
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/feeexcluder"
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+func OvgFeesHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("denom", payload.Input.Denom).
+		Int64("height", payload.Input.Height).
+		Msg("executing overgold fees action")
+
+	if ctx.Sources.OverGoldFeeExcluderSource == nil {
+		return nil, fmt.Errorf("overgold fee excluder source is not available")
+	}
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var denoms []string
+	if payload.Input.Denom != "" {
+		denoms = []string{payload.Input.Denom}
+	}
+
+	tariffs, err := ctx.Sources.OverGoldFeeExcluderSource.GetTariffs(denoms, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting overgold fees: %s", err)
+	}
+
+	return types.OvgFeesResponse{
+		DataSource: types.NewDataSource(types.SourceNode, height),
+		Tariffs:    tariffsFees(tariffs),
+	}, nil
+}
+
+// OvgFeesFallbackHandler returns the fees of the tariffs stored inside the database
+func OvgFeesFallbackHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	height, err := ctx.GetDatabaseHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	f := filter.NewFilter()
+	if payload.Input.Denom != "" {
+		f = f.SetArgument(dbtypes.FieldDenom, payload.Input.Denom)
+	}
+
+	tariffs, err := feeexcluder.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc).GetAllTariffs(f)
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting fee excluder tariffs: %s", err)
+	}
+
+	res := make([]*fe.Tariffs, len(tariffs))
+	for i := range tariffs {
+		res[i] = &tariffs[i]
+	}
+
+	return types.OvgFeesResponse{
+		DataSource: types.NewDataSource(types.SourceDatabase, height),
+		Tariffs:    tariffsFees(res),
+	}, nil
+}
+
+// tariffsFees returns the fees of the given tariffs grouped by tariff
+func tariffsFees(tariffs []*fe.Tariffs) []types.OvgTariffFees {
+	var res []types.OvgTariffFees
+	for _, t := range tariffs {
+		for _, tariff := range t.Tariffs {
+			res = append(res, types.OvgTariffFees{
+				Denom:    t.Denom,
+				TariffID: tariff.Id,
+				Fees:     tariff.Fees,
+			})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"testing"
+
+	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+func TestTariffsFees(t *testing.T) {
+	first := &fe.Fees{Id: 1, Fee: "0.01"}
+	second := &fe.Fees{Id: 2, Fee: "0.02"}
+	third := &fe.Fees{Id: 1, Fee: "0.03"}
+
+	fees := tariffsFees([]*fe.Tariffs{
+		{Denom: "ovg", Tariffs: []*fe.Tariff{
+			{Id: 1, Fees: []*fe.Fees{first, second}},
+			{Id: 2, Fees: []*fe.Fees{}},
+		}},
+		{Denom: "stovg", Tariffs: []*fe.Tariff{
+			{Id: 1, Fees: []*fe.Fees{third}},
+		}},
+	})
+
+	require.Equal(t, []types.OvgTariffFees{
+		{Denom: "ovg", TariffID: 1, Fees: []*fe.Fees{first, second}},
+		{Denom: "ovg", TariffID: 2, Fees: []*fe.Fees{}},
+		{Denom: "stovg", TariffID: 1, Fees: []*fe.Fees{third}},
+	}, fees)
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"fmt"
+
+	allowedtypes "git.ooo.ua/vipcoin/ovg-chain/x/allowed/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/allowed"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+func OvgIsAllowedHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Int64("height", payload.Input.Height).
+		Msg("executing overgold is allowed action")
+
+	if ctx.Sources.OverGoldAllowedSource == nil {
+		return nil, fmt.Errorf("overgold allowed source is not available")
+	}
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := ctx.Sources.OverGoldAllowedSource.GetAddresses([]string{payload.GetAddress()}, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting overgold allowed addresses: %s", err)
+	}
+
+	return types.OvgIsAllowedResponse{
+		DataSource: types.NewDataSource(types.SourceNode, height),
+		Address:    payload.GetAddress(),
+		Allowed:    isAllowed(addresses, payload.GetAddress()),
+	}, nil
+}
+
+// OvgIsAllowedFallbackHandler tells whether the address is allowed based on the addresses stored inside the database
+func OvgIsAllowedFallbackHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	height, err := ctx.GetDatabaseHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	isAllowed, err := allowed.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc).IsAllowedAddress(payload.GetAddress())
+	if err != nil {
+		return nil, fmt.Errorf("error while checking overgold allowed address: %s", err)
+	}
+
+	return types.OvgIsAllowedResponse{
+		DataSource: types.NewDataSource(types.SourceDatabase, height),
+		Address:    payload.GetAddress(),
+		Allowed:    isAllowed,
+	}, nil
+}
+
+// isAllowed tells whether the given address is contained inside the given allowed addresses
+func isAllowed(addresses []*allowedtypes.Addresses, address string) bool {
+	for _, entry := range addresses {
+		for _, allowedAddress := range entry.Address {
+			if allowedAddress == address {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/referral"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+func OvgReferrerHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Int64("height", payload.Input.Height).
+		Msg("executing overgold referrer action")
+
+	if ctx.Sources.OverGoldReferralSource == nil {
+		return nil, fmt.Errorf("overgold referral source is not available")
+	}
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	referrer, err := ctx.Sources.OverGoldReferralSource.GetReferrer(payload.GetAddress(), height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting overgold referrer: %s", err)
+	}
+
+	return types.OvgReferrerResponse{
+		DataSource: types.NewDataSource(types.SourceNode, height),
+		Address:    payload.GetAddress(),
+		Referrer:   referrer,
+	}, nil
+}
+
+// OvgReferrerFallbackHandler returns the current referrer of the address stored inside the database
+func OvgReferrerFallbackHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	height, err := ctx.GetDatabaseHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	var referrer string
+	link, err := referral.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc).GetReferralLink(payload.GetAddress())
+	switch {
+	case err == nil:
+		referrer = link.ReferrerAddress
+	case !errors.As(err, &errs.NotFound{}):
+		return nil, fmt.Errorf("error while getting referral link: %s", err)
+	}
+
+	return types.OvgReferrerResponse{
+		DataSource: types.NewDataSource(types.SourceDatabase, height),
+		Address:    payload.GetAddress(),
+		Referrer:   referrer,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+func OvgStakesHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Int64("height", payload.Input.Height).
+		Msg("executing overgold stakes action")
+
+	if ctx.Sources.OverGoldStakeSource == nil {
+		return nil, fmt.Errorf("overgold stake source is not available")
+	}
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	stakes, err := ctx.Sources.OverGoldStakeSource.GetStakes([]string{payload.GetAddress()}, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting overgold stakes: %s", err)
+	}
+
+	return types.OvgStakesResponse{
+		DataSource: types.NewDataSource(types.SourceNode, height),
+		Stakes:     stakes,
+	}, nil
+}
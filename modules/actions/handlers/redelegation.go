@@ -19,10 +19,15 @@ func RedelegationHandler(ctx *types.Context, payload *types.Payload) (interface{
 		return nil, err
 	}
 
+	pagination, err := payload.GetPagination()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get delegator's redelegations
 	redelegations, err := ctx.Sources.StakingSource.GetRedelegations(height, &stakingtypes.QueryRedelegationsRequest{
 		DelegatorAddr: payload.GetAddress(),
-		Pagination:    payload.GetPagination(),
+		Pagination:    pagination,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error while getting delegator redelegations: %s", err)
@@ -49,6 +54,6 @@ func RedelegationHandler(ctx *types.Context, payload *types.Payload) (interface{
 
 	return types.RedelegationResponse{
 		Redelegations: redelegationsList,
-		Pagination:    redelegations.Pagination,
+		Pagination:    types.NewPagination(redelegations.Pagination, height),
 	}, nil
 }
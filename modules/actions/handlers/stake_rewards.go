@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/stake"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+// defaultStakeRewardHistoryLimit is used when no limit is provided with the request
+const defaultStakeRewardHistoryLimit = 100
+
+func StakePendingRewardsHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Msg("executing stake pending rewards action")
+
+	balances, err := stake.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc).GetRewardBalances(payload.GetAddress())
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting stake reward balances: %s", err)
+	}
+
+	pending := sdk.NewCoins()
+	for _, balance := range balances {
+		pending = pending.Add(balance.Pending())
+	}
+
+	return types.Balance{
+		Coins: types.ConvertCoins(pending),
+	}, nil
+}
+
+func StakeRewardHistoryHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Uint64("offset", payload.Input.Offset).
+		Uint64("limit", payload.Input.Limit).
+		Msg("executing stake reward history action")
+
+	limit := payload.Input.Limit
+	if limit == 0 {
+		limit = defaultStakeRewardHistoryLimit
+	}
+
+	entries, err := stake.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc).
+		GetRewardHistory(payload.GetAddress(), payload.Input.Offset, limit)
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting stake reward history: %s", err)
+	}
+
+	entriesList := make([]types.StakeRewardEntry, len(entries))
+	for index, entry := range entries {
+		entriesList[index] = types.StakeRewardEntry{
+			TxHash:    entry.TxHash,
+			Height:    entry.Height,
+			Kind:      entry.Kind,
+			Coin:      types.Coin{Amount: entry.Amount.Amount.String(), Denom: entry.Amount.Denom},
+			Timestamp: entry.Timestamp,
+		}
+	}
+
+	return types.StakeRewardHistoryResponse{
+		Entries: entriesList,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/stake"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+const (
+	// defaultStakeFlowDays is the number of days returned when no days are provided with the request
+	defaultStakeFlowDays = 30
+
+	// stakeTopCounterparties is the number of counterparties returned with the flows
+	stakeTopCounterparties = 10
+)
+
+func StakeTransferFlowsHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Uint64("days", payload.Input.Days).
+		Msg("executing stake transfer flows action")
+
+	days := payload.Input.Days
+	if days == 0 {
+		days = defaultStakeFlowDays
+	}
+
+	repo := stake.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc)
+	response := types.StakeTransferFlowsResponse{
+		Daily:          []types.StakeDailyFlow{},
+		Counterparties: []types.StakeCounterpartyFlow{},
+	}
+
+	to := time.Now().UTC()
+	from := to.AddDate(0, 0, -int(days))
+	daily, err := repo.GetStakeDailyFlows(payload.GetAddress(), from, to)
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting stake daily flows: %s", err)
+	}
+
+	for _, flow := range daily {
+		response.Daily = append(response.Daily, types.StakeDailyFlow{
+			Date: flow.Date.Format(time.DateOnly),
+			In:   flow.In,
+			Out:  flow.Out,
+			Net:  flow.Net(),
+		})
+	}
+
+	counterparties, err := repo.GetStakeTopCounterparties(payload.GetAddress(), stakeTopCounterparties)
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting stake counterparties: %s", err)
+	}
+
+	for _, flow := range counterparties {
+		response.Counterparties = append(response.Counterparties, types.StakeCounterpartyFlow{
+			Counterparty: flow.Counterparty,
+			In:           flow.In,
+			Out:          flow.Out,
+			Transfers:    flow.Transfers,
+		})
+	}
+
+	total, err := repo.GetStakeTotalFlow(payload.GetAddress())
+	switch {
+	case err == nil:
+		response.Total = &types.StakeTotalFlow{In: total.In, Out: total.Out, Net: total.Net(), Height: total.Height}
+	case !errors.As(err, &errs.NotFound{}):
+		return nil, fmt.Errorf("error while getting stake total flow: %s", err)
+	}
+
+	return response, nil
+}
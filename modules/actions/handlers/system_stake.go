@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/stake"
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+func SystemStakeHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Int64("height", payload.Input.Height).
+		Msg("executing system stake action")
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := stake.NewRepository(ctx.Db.Sqlx, ctx.Db.Cdc)
+
+	accounts, err := repo.GetSystemStakeAccounts()
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting system stake accounts: %s", err)
+	}
+
+	totals, err := repo.GetSystemStakeTotals(height)
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, fmt.Errorf("error while getting system stake totals: %s", err)
+	}
+
+	accountsList := make([]types.SystemStakeAccount, len(accounts))
+	for index, account := range accounts {
+		accountsList[index] = types.SystemStakeAccount{
+			Address:       account.Address,
+			Kind:          account.Kind,
+			Active:        account.Active,
+			CreatedHeight: account.CreatedHeight,
+			UpdatedHeight: account.UpdatedHeight,
+		}
+	}
+
+	totalsList := make([]types.SystemStakeTotal, len(totals))
+	for index, total := range totals {
+		totalsList[index] = types.SystemStakeTotal{
+			Kind:    total.Kind,
+			Address: total.Address,
+			Total:   total.Total,
+			Height:  total.Height,
+		}
+	}
+
+	return types.SystemStakeResponse{
+		Height:   height,
+		Accounts: accountsList,
+		Totals:   totalsList,
+	}, nil
+}
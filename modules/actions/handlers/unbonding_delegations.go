@@ -18,8 +18,13 @@ func UnbondingDelegationsHandler(ctx *types.Context, payload *types.Payload) (in
 		return nil, err
 	}
 
+	pagination, err := payload.GetPagination()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get all unbonding delegations for given delegator address
-	unbondingDelegations, err := ctx.Sources.StakingSource.GetUnbondingDelegations(height, payload.GetAddress(), payload.GetPagination())
+	unbondingDelegations, err := ctx.Sources.StakingSource.GetUnbondingDelegations(height, payload.GetAddress(), pagination)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting delegator unbonding delegations: %s", err)
 	}
@@ -35,6 +40,6 @@ func UnbondingDelegationsHandler(ctx *types.Context, payload *types.Payload) (in
 
 	return types.UnbondingDelegationResponse{
 		UnbondingDelegations: unbondingDelegationsList,
-		Pagination:           unbondingDelegations.Pagination,
+		Pagination:           types.NewPagination(unbondingDelegations.Pagination, height),
 	}, nil
 }
@@ -26,6 +26,7 @@ func ValidatorCommissionAmountHandler(ctx *types.Context, payload *types.Payload
 	}
 
 	return types.ValidatorCommissionAmount{
-		Coins: types.ConvertDecCoins(commission),
+		DataSource: types.NewDataSource(types.SourceNode, height),
+		Coins:      types.ConvertDecCoins(commission),
 	}, nil
 }
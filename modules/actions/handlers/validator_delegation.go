@@ -20,8 +20,13 @@ func ValidatorDelegation(ctx *types.Context, payload *types.Payload) (interface{
 		return nil, err
 	}
 
+	pagination, err := payload.GetPagination()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get validator's total delegations
-	res, err := ctx.Sources.StakingSource.GetValidatorDelegationsWithPagination(height, payload.GetAddress(), payload.GetPagination())
+	res, err := ctx.Sources.StakingSource.GetValidatorDelegationsWithPagination(height, payload.GetAddress(), pagination)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting validator delegations: %s", err)
 	}
@@ -36,7 +41,8 @@ func ValidatorDelegation(ctx *types.Context, payload *types.Payload) (interface{
 	}
 
 	return types.DelegationResponse{
+		DataSource:  types.NewDataSource(types.SourceNode, height),
 		Delegations: delegations,
-		Pagination:  res.Pagination,
+		Pagination:  types.NewPagination(res.Pagination, height),
 	}, nil
 }
@@ -19,10 +19,15 @@ func ValidatorRedelegationsFromHandler(ctx *types.Context, payload *types.Payloa
 		return nil, err
 	}
 
+	pagination, err := payload.GetPagination()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get redelegations from a source validator address
 	redelegations, err := ctx.Sources.StakingSource.GetRedelegations(height, &stakingtypes.QueryRedelegationsRequest{
 		SrcValidatorAddr: payload.GetAddress(),
-		Pagination:       payload.GetPagination(),
+		Pagination:       pagination,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error while getting redelegations from validator: %s", err)
@@ -47,7 +52,8 @@ func ValidatorRedelegationsFromHandler(ctx *types.Context, payload *types.Payloa
 	}
 
 	return types.RedelegationResponse{
+		DataSource:    types.NewDataSource(types.SourceNode, height),
 		Redelegations: redelegationsList,
-		Pagination:    redelegations.Pagination,
+		Pagination:    types.NewPagination(redelegations.Pagination, height),
 	}, nil
 }
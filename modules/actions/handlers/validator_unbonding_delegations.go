@@ -19,11 +19,16 @@ func ValidatorUnbondingDelegationsHandler(ctx *types.Context, payload *types.Pay
 		return nil, err
 	}
 
+	pagination, err := payload.GetPagination()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get all unbonding delegations from the given validator opr address
 	unbondingDelegations, err := ctx.Sources.StakingSource.GetUnbondingDelegationsFromValidator(
 		height,
 		payload.GetAddress(),
-		payload.GetPagination(),
+		pagination,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting all unbonding delegations from validator %s: %s",
@@ -40,7 +45,8 @@ func ValidatorUnbondingDelegationsHandler(ctx *types.Context, payload *types.Pay
 	}
 
 	return types.UnbondingDelegationResponse{
+		DataSource:           types.NewDataSource(types.SourceNode, height),
 		UnbondingDelegations: unbondingDelegationsList,
-		Pagination:           unbondingDelegations.Pagination,
+		Pagination:           types.NewPagination(unbondingDelegations.Pagination, height),
 	}, nil
 }
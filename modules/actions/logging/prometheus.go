@@ -27,6 +27,22 @@ var ActionErrorCounter = prometheus.NewCounterVec(
 	}, []string{"path", "http_status_code"},
 )
 
+// ActionCacheHitCounter represents the Telemetry counter used to track the number of responses served from the cache
+var ActionCacheHitCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "bdjuno_actions_cache_hit_count",
+		Help: "Total number of action responses served from the cache.",
+	}, []string{"path"},
+)
+
+// ActionCacheMissCounter represents the Telemetry counter used to track the number of responses not found in the cache
+var ActionCacheMissCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "bdjuno_actions_cache_miss_count",
+		Help: "Total number of action responses not found in the cache.",
+	}, []string{"path"},
+)
+
 func init() {
 	err := prometheus.Register(ActionResponseTime)
 	if err != nil {
@@ -42,4 +58,14 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	err = prometheus.Register(ActionCacheHitCounter)
+	if err != nil {
+		panic(err)
+	}
+
+	err = prometheus.Register(ActionCacheMissCounter)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -14,6 +14,18 @@ func ErrorCounter(path string) {
 	ActionErrorCounter.WithLabelValues(path, fmt.Sprintf("%d", http.StatusInternalServerError)).Inc()
 }
 
+func RejectedCounter(path string, status int) {
+	ActionErrorCounter.WithLabelValues(path, fmt.Sprintf("%d", status)).Inc()
+}
+
+func CacheHitCounter(path string) {
+	ActionCacheHitCounter.WithLabelValues(path).Inc()
+}
+
+func CacheMissCounter(path string) {
+	ActionCacheMissCounter.WithLabelValues(path).Inc()
+}
+
 func ReponseTimeBuckets(path string, start time.Time) {
 	ActionResponseTime.WithLabelValues(path).
 		Observe(time.Since(start).Seconds())
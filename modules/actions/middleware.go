@@ -0,0 +1,216 @@
+package actions
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/logging"
+	actionstypes "github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+const (
+	// limitersCleanupInterval represents how often the idle per-IP limiters are removed
+	limitersCleanupInterval = time.Minute
+
+	// limiterIdleTimeout represents after how long a per-IP limiter is considered idle
+	limiterIdleTimeout = 3 * time.Minute
+)
+
+// buildMiddlewares returns the middlewares that should wrap each handler based on the given config
+func buildMiddlewares(cfg *Config) []actionstypes.Middleware {
+	var middlewares []actionstypes.Middleware
+
+	if cfg.RequestTimeout > 0 {
+		middlewares = append(middlewares, timeoutMiddleware(cfg.RequestTimeout))
+	}
+
+	if cfg.RateLimit != nil && cfg.RateLimit.PerIP != nil {
+		middlewares = append(middlewares, ipRateLimitMiddleware(*cfg.RateLimit.PerIP, cfg.RateLimit.TrustForwardedFor))
+	}
+
+	if cfg.Auth != nil && cfg.Auth.Secret != "" {
+		middlewares = append(middlewares, authMiddleware(cfg.Auth.Header, cfg.Auth.Secret))
+	}
+
+	if cfg.RateLimit != nil && len(cfg.RateLimit.PerAction) > 0 {
+		middlewares = append(middlewares, actionRateLimitMiddleware(cfg.RateLimit.PerAction))
+	}
+
+	if cfg.MaxBodySize > 0 {
+		middlewares = append(middlewares, maxBodySizeMiddleware(cfg.MaxBodySize))
+	}
+
+	return middlewares
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// timeoutMiddleware aborts the requests taking longer than the given timeout.
+// The deadline is set on the request context, which cancels the node queries made by the handlers as well.
+func timeoutMiddleware(timeout time.Duration) actionstypes.Middleware {
+	return func(path string, next http.HandlerFunc) http.HandlerFunc {
+		handler := http.TimeoutHandler(next, timeout, `{"message":"request timeout"}`)
+		return func(writer http.ResponseWriter, request *http.Request) {
+			recorder := &statusRecorder{ResponseWriter: writer, status: http.StatusOK}
+			handler.ServeHTTP(recorder, request)
+
+			// The handlers never reply with this status, so it can only come from the timeout
+			if recorder.status == http.StatusServiceUnavailable {
+				logging.RejectedCounter(path, http.StatusServiceUnavailable)
+			}
+		}
+	}
+}
+
+// statusRecorder allows to know which status code has been written to the wrapped writer
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// authMiddleware rejects the requests that do not contain the given secret inside the given header
+func authMiddleware(header, secret string) actionstypes.Middleware {
+	return func(path string, next http.HandlerFunc) http.HandlerFunc {
+		return func(writer http.ResponseWriter, request *http.Request) {
+			value := request.Header.Get(header)
+			if subtle.ConstantTimeCompare([]byte(value), []byte(secret)) != 1 {
+				actionstypes.RejectRequest(writer, path, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			next(writer, request)
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// maxBodySizeMiddleware limits the size of the request bodies to the given amount of bytes
+func maxBodySizeMiddleware(maxSize int64) actionstypes.Middleware {
+	return func(path string, next http.HandlerFunc) http.HandlerFunc {
+		return func(writer http.ResponseWriter, request *http.Request) {
+			if request.ContentLength > maxSize {
+				actionstypes.RejectRequest(writer, path, http.StatusRequestEntityTooLarge, "payload too large")
+				return
+			}
+
+			request.Body = http.MaxBytesReader(writer, request.Body, maxSize)
+			next(writer, request)
+		}
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// actionRateLimitMiddleware limits the requests made to each action, regardless of the client.
+// Actions that are not present inside the given limits are not limited.
+func actionRateLimitMiddleware(limits map[string]LimitConfig) actionstypes.Middleware {
+	return func(path string, next http.HandlerFunc) http.HandlerFunc {
+		limit, ok := limits[path]
+		if !ok {
+			limit, ok = limits[strings.TrimPrefix(path, "/")]
+		}
+		if !ok {
+			return next
+		}
+
+		limiter := rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+		return func(writer http.ResponseWriter, request *http.Request) {
+			if !limiter.Allow() {
+				actionstypes.RejectRequest(writer, path, http.StatusTooManyRequests, "too many requests")
+				return
+			}
+
+			next(writer, request)
+		}
+	}
+}
+
+// ipRateLimitMiddleware limits the requests made by each client across all the actions
+func ipRateLimitMiddleware(limit LimitConfig, trustForwardedFor bool) actionstypes.Middleware {
+	limiters := newIPLimiters(limit)
+	return func(path string, next http.HandlerFunc) http.HandlerFunc {
+		return func(writer http.ResponseWriter, request *http.Request) {
+			if !limiters.Allow(clientIP(request, trustForwardedFor)) {
+				actionstypes.RejectRequest(writer, path, http.StatusTooManyRequests, "too many requests")
+				return
+			}
+
+			next(writer, request)
+		}
+	}
+}
+
+// clientIP returns the IP address of the client that has made the given request
+func clientIP(request *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if forwarded := request.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
+
+// ipLimiters contains a token bucket for each client IP address
+type ipLimiters struct {
+	mu          sync.Mutex
+	limit       LimitConfig
+	limiters    map[string]*ipLimiter
+	lastCleanup time.Time
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newIPLimiters(limit LimitConfig) *ipLimiters {
+	return &ipLimiters{
+		limit:       limit,
+		limiters:    make(map[string]*ipLimiter),
+		lastCleanup: time.Now(),
+	}
+}
+
+// Allow tells whether the given IP address can perform a request now
+func (l *ipLimiters) Allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastCleanup) > limitersCleanupInterval {
+		for key, entry := range l.limiters {
+			if now.Sub(entry.lastSeen) > limiterIdleTimeout {
+				delete(l.limiters, key)
+			}
+		}
+		l.lastCleanup = now
+	}
+
+	entry, ok := l.limiters[ip]
+	if !ok {
+		entry = &ipLimiter{limiter: rate.NewLimiter(rate.Limit(l.limit.Rate), l.limit.Burst)}
+		l.limiters[ip] = entry
+	}
+	entry.lastSeen = now
+
+	return entry.limiter.Allow()
+}
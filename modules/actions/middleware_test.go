@@ -0,0 +1,109 @@
+package actions
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// serve sends a request having the given body to the given handler, returning the recorded response
+func serve(handler http.HandlerFunc, body string, headers map[string]string, remoteAddr string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/action", strings.NewReader(body))
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	if remoteAddr != "" {
+		request.RemoteAddr = remoteAddr
+	}
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, request)
+	return recorder
+}
+
+func okHandler(writer http.ResponseWriter, _ *http.Request) {
+	writer.WriteHeader(http.StatusOK)
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	handler := authMiddleware("X-Actions-Secret", "secret")("/action", okHandler)
+
+	require.Equal(t, http.StatusUnauthorized, serve(handler, "", nil, "").Code)
+	require.Equal(t, http.StatusUnauthorized, serve(handler, "", map[string]string{"X-Actions-Secret": "wrong"}, "").Code)
+	require.Equal(t, http.StatusOK, serve(handler, "", map[string]string{"X-Actions-Secret": "secret"}, "").Code)
+}
+
+func TestMaxBodySizeMiddleware(t *testing.T) {
+	var read []byte
+	var readErr error
+	handler := maxBodySizeMiddleware(8)("/action", func(writer http.ResponseWriter, request *http.Request) {
+		read, readErr = io.ReadAll(request.Body)
+		writer.WriteHeader(http.StatusOK)
+	})
+
+	recorder := serve(handler, "12345678", nil, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NoError(t, readErr)
+	require.Equal(t, "12345678", string(read))
+
+	recorder = serve(handler, "123456789", nil, "")
+	require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+
+	// Bodies without a declared length are limited while being read
+	request := httptest.NewRequest(http.MethodPost, "/action", io.NopCloser(strings.NewReader("123456789")))
+	request.ContentLength = -1
+	handler(httptest.NewRecorder(), request)
+	require.Error(t, readErr)
+}
+
+func TestActionRateLimitMiddleware(t *testing.T) {
+	middleware := actionRateLimitMiddleware(map[string]LimitConfig{"action": {Rate: 0.001, Burst: 2}})
+
+	limited := middleware("/action", okHandler)
+	for i := 0; i < 2; i++ {
+		require.Equal(t, http.StatusOK, serve(limited, "", nil, "").Code)
+	}
+	require.Equal(t, http.StatusTooManyRequests, serve(limited, "", nil, "").Code)
+
+	// Actions without limits are never rejected
+	unlimited := middleware("/other", okHandler)
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusOK, serve(unlimited, "", nil, "").Code)
+	}
+}
+
+func TestIPRateLimitMiddleware(t *testing.T) {
+	handler := ipRateLimitMiddleware(LimitConfig{Rate: 0.001, Burst: 1}, false)("/action", okHandler)
+
+	require.Equal(t, http.StatusOK, serve(handler, "", nil, "10.0.0.1:1234").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(handler, "", nil, "10.0.0.1:5678").Code)
+	require.Equal(t, http.StatusOK, serve(handler, "", nil, "10.0.0.2:1234").Code)
+
+	// Forwarded addresses are ignored unless trusted
+	forwarded := map[string]string{"X-Forwarded-For": "10.0.0.3"}
+	require.Equal(t, http.StatusTooManyRequests, serve(handler, "", forwarded, "10.0.0.1:1234").Code)
+
+	trusted := ipRateLimitMiddleware(LimitConfig{Rate: 0.001, Burst: 1}, true)("/action", okHandler)
+	require.Equal(t, http.StatusOK, serve(trusted, "", forwarded, "10.0.0.1:1234").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(trusted, "", forwarded, "10.0.0.4:1234").Code)
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	handlerErr := make(chan error, 1)
+	handler := timeoutMiddleware(10*time.Millisecond)("/action", func(writer http.ResponseWriter, request *http.Request) {
+		// The request context is cancelled once the timeout is reached
+		<-request.Context().Done()
+		handlerErr <- request.Context().Err()
+	})
+
+	recorder := serve(handler, "", nil, "")
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.JSONEq(t, `{"message":"request timeout"}`, recorder.Body.String())
+	require.ErrorIs(t, <-handlerErr, context.DeadlineExceeded)
+}
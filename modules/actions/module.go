@@ -8,6 +8,8 @@ import (
 	nodeconfig "github.com/forbole/juno/v5/node/config"
 	"github.com/forbole/juno/v5/types/config"
 
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/nodepool"
 	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 )
 
@@ -24,9 +26,10 @@ type Module struct {
 	cfg     *Config
 	node    node.Node
 	sources *modulestypes.Sources
+	db      *database.Db
 }
 
-func NewModule(cfg config.Config, encodingConfig *params.EncodingConfig) *Module {
+func NewModule(cfg config.Config, encodingConfig *params.EncodingConfig, db *database.Db) *Module {
 	bz, err := cfg.GetBytes()
 	if err != nil {
 		panic(err)
@@ -37,6 +40,20 @@ func NewModule(cfg config.Config, encodingConfig *params.EncodingConfig) *Module
 		panic(err)
 	}
 
+	if actionsCfg.NodePool != nil {
+		pool, err := nodepool.NewPool(actionsCfg.NodePool, encodingConfig.Codec)
+		if err != nil {
+			panic(err)
+		}
+
+		return &Module{
+			cfg:     actionsCfg,
+			node:    pool,
+			sources: modulestypes.BuildPoolSources(pool),
+			db:      db,
+		}
+	}
+
 	nodeCfg := cfg.Node
 	if actionsCfg.Node != nil {
 		nodeCfg = nodeconfig.NewConfig(nodeconfig.TypeRemote, actionsCfg.Node)
@@ -58,6 +75,7 @@ func NewModule(cfg config.Config, encodingConfig *params.EncodingConfig) *Module
 		cfg:     actionsCfg,
 		node:    junoNode,
 		sources: sources,
+		db:      db,
 	}
 }
 
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BatchPathSuffix represents the suffix of the paths on which the batch variants of the handlers are registered
+const BatchPathSuffix = "_batch"
+
+const (
+	DefaultBatchMaxAddresses = 100
+	DefaultBatchConcurrency  = 8
+)
+
+// BatchResult contains the outcome of a handler executed for a single address of a batch
+type BatchResult struct {
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// BatchResponse contains the outcome of a batch request, keyed by address
+type BatchResponse struct {
+	Results map[string]BatchResult `json:"results"`
+}
+
+// IsFallback tells whether the result of any address has been read from the database instead of the node
+func (r BatchResponse) IsFallback() bool {
+	for _, result := range r.Results {
+		if fallback, ok := result.Result.(fallbackResponse); ok && fallback.IsFallback() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrors tells whether the handler failed for any address
+func (r BatchResponse) HasErrors() bool {
+	for _, result := range r.Results {
+		if result.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// partialResponse represents a response that might contain the errors of some of its parts
+type partialResponse interface {
+	HasErrors() bool
+}
+
+// SetBatchLimits sets the maximum number of addresses accepted by a single batch request,
+// and how many of them are handled concurrently. Non-positive values leave the current limits unchanged.
+func (w *ActionsWorker) SetBatchLimits(maxAddresses, concurrency int) {
+	if maxAddresses > 0 {
+		w.batchMaxAddresses = maxAddresses
+	}
+	if concurrency > 0 {
+		w.batchConcurrency = concurrency
+	}
+}
+
+// batchHandler returns an ActionHandler that executes the given handler once for each address
+// inside the payload, running at most batchConcurrency of them at the same time
+func (w *ActionsWorker) batchHandler(handler ActionHandler) ActionHandler {
+	return func(context *Context, payload *Payload) (interface{}, error) {
+		addresses := uniqueAddresses(payload.Input.Addresses)
+		if len(addresses) == 0 {
+			return nil, fmt.Errorf("addresses cannot be empty")
+		}
+
+		if len(addresses) > w.batchMaxAddresses {
+			return nil, fmt.Errorf("too many addresses: %d, max %d", len(addresses), w.batchMaxAddresses)
+		}
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, w.batchConcurrency)
+		results := make(map[string]BatchResult, len(addresses))
+
+		for _, address := range addresses {
+			single := *payload
+			single.Input.Address = address
+			single.Input.Addresses = nil
+
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(address string, payload *Payload) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+
+				var result BatchResult
+				res, err := handler(context, payload)
+				if err != nil {
+					result.Error = err.Error()
+				} else {
+					result.Result = res
+				}
+
+				mu.Lock()
+				results[address] = result
+				mu.Unlock()
+			}(address, &single)
+		}
+
+		wg.Wait()
+		return BatchResponse{Results: results}, nil
+	}
+}
+
+// uniqueAddresses returns the non-empty addresses removing the duplicates, preserving their order
+func uniqueAddresses(addresses []string) []string {
+	seen := make(map[string]bool, len(addresses))
+	unique := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		unique = append(unique, address)
+	}
+	return unique
+}
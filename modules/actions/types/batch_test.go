@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheable_BatchResponse(t *testing.T) {
+	node := Balance{DataSource: NewDataSource(SourceNode, 10)}
+	db := Balance{DataSource: NewDataSource(SourceDatabase, 10)}
+
+	require.True(t, cacheable(BatchResponse{Results: map[string]BatchResult{
+		"address1": {Result: node},
+		"address2": {Result: node},
+	}}))
+
+	require.False(t, cacheable(BatchResponse{Results: map[string]BatchResult{
+		"address1": {Result: node},
+		"address2": {Result: db},
+	}}))
+
+	require.False(t, cacheable(BatchResponse{Results: map[string]BatchResult{
+		"address1": {Result: node},
+		"address2": {Error: "error while getting balance: context deadline exceeded"},
+	}}))
+}
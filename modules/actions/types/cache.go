@@ -0,0 +1,141 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/logging"
+)
+
+// CacheBackend represents a storage that can be used to cache the actions responses
+type CacheBackend interface {
+	// Get returns the value associated with the given key, if any
+	Get(key string) ([]byte, bool, error)
+
+	// Set stores the given value for the given ttl. A zero ttl means the value never expires
+	Set(key string, value []byte, ttl time.Duration) error
+}
+
+// ResponseCache caches the responses of the actions queries.
+// Responses of the handlers registered as immutable for an explicit height below the chain tip are cached
+// without expiration, while all the other ones are only cached for latestTTL.
+type ResponseCache struct {
+	backend      CacheBackend
+	latestTTL    time.Duration
+	excluded     map[string]bool
+	latestHeight func() (int64, error)
+
+	// tip is the highest chain height seen so far, used to avoid querying the latest height
+	// for responses that are already known to be below it
+	tip atomic.Int64
+}
+
+// NewResponseCache returns a new ResponseCache instance.
+// latestHeight is used to get the chain latest height when deciding whether a response can be cached forever.
+func NewResponseCache(
+	backend CacheBackend, latestTTL time.Duration, excludedPaths []string, latestHeight func() (int64, error),
+) *ResponseCache {
+	excluded := make(map[string]bool, len(excludedPaths))
+	for _, path := range excludedPaths {
+		excluded[path] = true
+	}
+
+	return &ResponseCache{
+		backend:      backend,
+		latestTTL:    latestTTL,
+		excluded:     excluded,
+		latestHeight: latestHeight,
+	}
+}
+
+// cacheKey returns the key used to cache the response to the given payload sent to the given path
+func cacheKey(path string, payload *Payload) (string, error) {
+	bz, err := json.Marshal(payload.Input)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s", path, bz), nil
+}
+
+// Get returns the cached response to the given payload sent to the given path, if any
+func (c *ResponseCache) Get(path string, payload *Payload) ([]byte, bool) {
+	if c.excluded[path] {
+		return nil, false
+	}
+
+	key, err := cacheKey(path, payload)
+	if err != nil {
+		return nil, false
+	}
+
+	data, found, err := c.backend.Get(key)
+	if err != nil {
+		log.Error().Str("action", path).Err(err).Msg("error while reading cached response")
+		return nil, false
+	}
+
+	if found {
+		logging.CacheHitCounter(path)
+	} else {
+		logging.CacheMissCounter(path)
+	}
+
+	return data, found
+}
+
+// Set caches the given response to the given payload sent to the given path.
+// The response is cached without expiration only if it is immutable and refers to an explicit height
+// strictly below the chain latest one, since the state at the tip can still be served by lagging nodes.
+func (c *ResponseCache) Set(path string, payload *Payload, data []byte, immutable bool) {
+	if c.excluded[path] {
+		return
+	}
+
+	ttl := c.latestTTL
+	if immutable && c.isFinal(path, payload.Input.Height) {
+		ttl = 0
+	} else if ttl <= 0 {
+		// Mutable responses are not cached at all
+		return
+	}
+
+	key, err := cacheKey(path, payload)
+	if err != nil {
+		return
+	}
+
+	err = c.backend.Set(key, data, ttl)
+	if err != nil {
+		log.Error().Str("action", path).Err(err).Msg("error while caching response")
+	}
+}
+
+// isFinal tells whether the given height is strictly below the chain latest height
+func (c *ResponseCache) isFinal(path string, height int64) bool {
+	if height <= 0 {
+		return false
+	}
+
+	if height < c.tip.Load() {
+		return true
+	}
+
+	latest, err := c.latestHeight()
+	if err != nil {
+		log.Error().Str("action", path).Err(err).Msg("error while getting chain latest height for caching")
+		return false
+	}
+
+	for {
+		tip := c.tip.Load()
+		if latest <= tip || c.tip.CompareAndSwap(tip, latest) {
+			break
+		}
+	}
+
+	return height < latest
+}
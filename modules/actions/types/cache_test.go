@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockBackend is a CacheBackend recording the ttl of the stored entries
+type mockBackend struct {
+	ttls map[string]time.Duration
+}
+
+func (b *mockBackend) Get(key string) ([]byte, bool, error) {
+	_, ok := b.ttls[key]
+	return nil, ok, nil
+}
+
+func (b *mockBackend) Set(key string, _ []byte, ttl time.Duration) error {
+	b.ttls[key] = ttl
+	return nil
+}
+
+func TestResponseCache_Set(t *testing.T) {
+	latestTTL := 5 * time.Second
+
+	testCases := []struct {
+		name      string
+		path      string
+		height    int64
+		immutable bool
+		latest    int64
+		latestErr error
+		cached    bool
+		ttl       time.Duration
+	}{
+		{name: "immutable below tip is cached forever", path: "/action", height: 99, immutable: true, latest: 100, cached: true, ttl: 0},
+		{name: "immutable at tip uses latest ttl", path: "/action", height: 100, immutable: true, latest: 100, cached: true, ttl: latestTTL},
+		{name: "immutable above tip uses latest ttl", path: "/action", height: 101, immutable: true, latest: 100, cached: true, ttl: latestTTL},
+		{name: "immutable without height uses latest ttl", path: "/action", immutable: true, latest: 100, cached: true, ttl: latestTTL},
+		{name: "latest height error uses latest ttl", path: "/action", height: 99, immutable: true, latestErr: fmt.Errorf("node down"), cached: true, ttl: latestTTL},
+		{name: "mutable uses latest ttl", path: "/action", height: 99, latest: 100, cached: true, ttl: latestTTL},
+		{name: "excluded path is not cached", path: "/excluded", height: 99, immutable: true, latest: 100},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &mockBackend{ttls: map[string]time.Duration{}}
+			cache := NewResponseCache(backend, latestTTL, []string{"/excluded"}, func() (int64, error) {
+				return tc.latest, tc.latestErr
+			})
+
+			payload := &Payload{Input: PayloadArgs{Address: "address", Height: tc.height}}
+			cache.Set(tc.path, payload, []byte("{}"), tc.immutable)
+
+			key, err := cacheKey(tc.path, payload)
+			require.NoError(t, err)
+
+			ttl, found := backend.ttls[key]
+			require.Equal(t, tc.cached, found)
+			if tc.cached {
+				require.Equal(t, tc.ttl, ttl)
+			}
+		})
+	}
+}
+
+func TestResponseCache_Set_MutableWithoutTTL(t *testing.T) {
+	backend := &mockBackend{ttls: map[string]time.Duration{}}
+	cache := NewResponseCache(backend, 0, nil, func() (int64, error) { return 100, nil })
+
+	cache.Set("/action", &Payload{Input: PayloadArgs{Height: 99}}, []byte("{}"), false)
+	cache.Set("/action", &Payload{Input: PayloadArgs{Height: 100}}, []byte("{}"), true)
+	require.Empty(t, backend.ttls)
+
+	cache.Set("/action", &Payload{Input: PayloadArgs{Height: 99}}, []byte("{}"), true)
+	require.Len(t, backend.ttls, 1)
+}
+
+func TestResponseCache_Set_ReusesKnownTip(t *testing.T) {
+	calls := 0
+	backend := &mockBackend{ttls: map[string]time.Duration{}}
+	cache := NewResponseCache(backend, time.Second, nil, func() (int64, error) {
+		calls++
+		return 100, nil
+	})
+
+	cache.Set("/action", &Payload{Input: PayloadArgs{Height: 50}}, []byte("{}"), true)
+	cache.Set("/action", &Payload{Input: PayloadArgs{Height: 60}}, []byte("{}"), true)
+	require.Equal(t, 1, calls)
+
+	// Heights not below the known tip must query the latest height again
+	cache.Set("/action", &Payload{Input: PayloadArgs{Height: 100}}, []byte("{}"), true)
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	SourceNode     = "node"
+	SourceDatabase = "db"
+)
+
+// DataSource tells where the data contained inside a response has been read from,
+// and at which height
+type DataSource struct {
+	Source string `json:"source,omitempty"`
+	Height int64  `json:"height,omitempty"`
+}
+
+// NewDataSource returns a new DataSource instance
+func NewDataSource(source string, height int64) DataSource {
+	return DataSource{
+		Source: source,
+		Height: height,
+	}
+}
+
+// IsFallback tells whether the data has been read from the database instead of the node
+func (s DataSource) IsFallback() bool {
+	return s.Source == SourceDatabase
+}
+
+// GetDataSource returns where the data has been read from
+func (s DataSource) GetDataSource() DataSource {
+	return s
+}
+
+// fallbackResponse represents a response that might have been built by a fallback handler
+type fallbackResponse interface {
+	IsFallback() bool
+}
+
+// sourcedResponse represents a response telling where its data has been read from
+type sourcedResponse interface {
+	GetDataSource() DataSource
+}
+
+// EnableFallback enables the database fallback for the actions registered on the given paths
+func (w *ActionsWorker) EnableFallback(paths ...string) {
+	for _, path := range paths {
+		w.fallbacks[path] = true
+	}
+}
+
+// RegisterHandlerWithFallback registers the provided handler to be used on each call to the provided path.
+// If the fallback has been enabled for the path, the fallback handler is executed every time the handler fails,
+// so that the data indexed inside the database is returned while the node is unavailable.
+func (w *ActionsWorker) RegisterHandlerWithFallback(path string, handler, fallback ActionHandler, opts ...HandlerOption) {
+	if !w.fallbacks[path] {
+		w.RegisterHandler(path, handler, opts...)
+		return
+	}
+
+	w.RegisterHandler(path, func(context *Context, payload *Payload) (interface{}, error) {
+		res, err := handler(context, payload)
+		if err == nil {
+			return res, nil
+		}
+
+		log.Warn().Str("action", path).Err(err).Msg("action failed, falling back to database")
+
+		fallbackRes, fallbackErr := fallback(context, payload)
+		if fallbackErr != nil {
+			log.Error().Str("action", path).Err(fallbackErr).Msg("error while executing database fallback")
+			return nil, err
+		}
+
+		return fallbackRes, nil
+	}, opts...)
+}
+
+// RegisterHandlerWithSnapshot registers the provided handler to be used on each call to the provided path,
+// for the actions whose data is not indexed inside the database. If the fallback has been enabled for the path,
+// the latest response returned by the node for each request is stored inside the database, and returned every
+// time the handler fails. The handler responses must embed a DataSource telling the height they have been read at.
+func (w *ActionsWorker) RegisterHandlerWithSnapshot(path string, handler ActionHandler, opts ...HandlerOption) {
+	if !w.fallbacks[path] {
+		w.RegisterHandler(path, handler, opts...)
+		return
+	}
+
+	w.RegisterHandlerWithFallback(path, snapshotHandler(path, handler), snapshotFallbackHandler(path), opts...)
+}
+
+// snapshotHandler returns an ActionHandler that stores the responses of the given handler inside the database
+func snapshotHandler(path string, handler ActionHandler) ActionHandler {
+	return func(context *Context, payload *Payload) (interface{}, error) {
+		res, err := handler(context, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		sourced, ok := res.(sourcedResponse)
+		if !ok {
+			return res, nil
+		}
+
+		// Failing to store the snapshot must not fail the request, which has been handled successfully
+		err = saveSnapshot(context, path, payload, res, sourced.GetDataSource().Height)
+		if err != nil {
+			log.Error().Str("action", path).Err(err).Msg("error while storing action snapshot")
+		}
+
+		return res, nil
+	}
+}
+
+// saveSnapshot stores the given response of the request having the given path and payload
+func saveSnapshot(context *Context, path string, payload *Payload, res interface{}, height int64) error {
+	key, err := snapshotKey(payload)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	return context.Db.SaveActionSnapshot(types.NewActionSnapshot(path, key, bz, height, time.Now()))
+}
+
+// snapshotFallbackHandler returns an ActionHandler returning the response stored for the request
+// having the given path and payload. Requests made at a given height are answered only by the responses
+// stored at that same height.
+func snapshotFallbackHandler(path string) ActionHandler {
+	return func(context *Context, payload *Payload) (interface{}, error) {
+		key, err := snapshotKey(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot, err := context.Db.GetActionSnapshot(path, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if snapshot == nil {
+			return nil, fmt.Errorf("no response stored for this request")
+		}
+
+		if payload.Input.Height > 0 && snapshot.Height != payload.Input.Height {
+			return nil, fmt.Errorf("no response stored at height %d", payload.Input.Height)
+		}
+
+		return snapshotResponse{
+			Data:       snapshot.Response,
+			DataSource: NewDataSource(SourceDatabase, snapshot.Height),
+		}, nil
+	}
+}
+
+// snapshotKey returns the key identifying the request having the given payload among the stored snapshots,
+// made of all its arguments but the height
+func snapshotKey(payload *Payload) (string, error) {
+	input := payload.Input
+	input.Height = 0
+
+	bz, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// snapshotResponse represents a response read from the stored snapshots
+type snapshotResponse struct {
+	Data json.RawMessage
+	DataSource
+}
+
+// MarshalJSON implements json.Marshaler, replacing the data source of the stored response
+func (r snapshotResponse) MarshalJSON() ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(r.Data, &fields); err != nil {
+		return nil, fmt.Errorf("error while reading action snapshot: %s", err)
+	}
+
+	source, err := json.Marshal(r.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := json.Marshal(r.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	fields["source"] = source
+	fields["height"] = height
+	return json.Marshal(fields)
+}
@@ -1,30 +1,64 @@
 package types
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/forbole/juno/v5/node"
 
+	"github.com/forbole/bdjuno/v4/database"
 	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 )
 
 // Context contains the data about a Hasura actions worker execution
 type Context struct {
+	ctx     context.Context
 	node    node.Node
 	Sources *modulestypes.Sources
+	Db      *database.Db
 }
 
 // NewContext returns a new Context instance
-func NewContext(node node.Node, sources *modulestypes.Sources) *Context {
+func NewContext(node node.Node, sources *modulestypes.Sources, db *database.Db) *Context {
 	return &Context{
+		ctx:     context.Background(),
 		node:    node,
 		Sources: sources,
+		Db:      db,
 	}
 }
 
-// GetHeight uses the lastest height when the input height is empty from graphql request
+// WithContext returns a copy of this context bound to the given request context, so that the node
+// queries made through its sources are cancelled once the request is cancelled or times out
+func (c *Context) WithContext(ctx context.Context) *Context {
+	return &Context{
+		ctx:     ctx,
+		node:    c.node,
+		Sources: c.Sources.WithContext(ctx),
+		Db:      c.Db,
+	}
+}
+
+// Context returns the context of the request being handled
+func (c *Context) Context() context.Context {
+	return c.ctx
+}
+
+// GetHeight uses the lastest height when the input height is empty from graphql request.
+// When the payload contains a cursor, the height at which the previous page has been read is used instead.
 func (c *Context) GetHeight(payload *Payload) (int64, error) {
+	if payload != nil && payload.Input.Height == 0 {
+		if height := payload.GetCursorHeight(); height > 0 {
+			return height, nil
+		}
+	}
+
 	if payload == nil || payload.Input.Height == 0 {
+		// The node client can not be cancelled, so avoid querying it for requests that are already done
+		if err := c.ctx.Err(); err != nil {
+			return 0, err
+		}
+
 		latestHeight, err := c.node.LatestHeight()
 		if err != nil {
 			return 0, fmt.Errorf("error while getting chain latest block height: %s", err)
@@ -35,6 +69,15 @@ func (c *Context) GetHeight(payload *Payload) (int64, error) {
 	return payload.Input.Height, nil
 }
 
+// GetDatabaseHeight returns the height of the latest block stored inside the database
+func (c *Context) GetDatabaseHeight() (int64, error) {
+	height, err := c.Db.GetLastBlockHeight()
+	if err != nil {
+		return 0, fmt.Errorf("error while getting database latest block height: %s", err)
+	}
+	return height, nil
+}
+
 // ActionHandler represents a Hasura action request handler.
 // It returns an interface to be returned to the called, or an error if something is wrong
 type ActionHandler = func(context *Context, payload *Payload) (interface{}, error)
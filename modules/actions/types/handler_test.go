@@ -0,0 +1,46 @@
+package types
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type contextKey struct{}
+
+func TestActionsWorker_RequestContext(t *testing.T) {
+	var handlerCtx context.Context
+	handler := func(ctx *Context, _ *Payload) (interface{}, error) {
+		handlerCtx = ctx.Context()
+		return Address{Address: "address"}, nil
+	}
+
+	worker := NewActionsWorker(NewContext(nil, nil, nil))
+	worker.RegisterHandler("/address", handler)
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "request")
+	request := httptest.NewRequest(http.MethodPost, "/address", strings.NewReader(`{"input":{}}`)).WithContext(ctx)
+	recorder := httptest.NewRecorder()
+	worker.mux.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NotNil(t, handlerCtx)
+	require.Equal(t, "request", handlerCtx.Value(contextKey{}))
+}
+
+func TestContext_GetHeight_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The node is never queried once the request has been cancelled
+	_, err := NewContext(nil, nil, nil).WithContext(ctx).GetHeight(&Payload{})
+	require.ErrorIs(t, err, context.Canceled)
+
+	height, err := NewContext(nil, nil, nil).WithContext(ctx).GetHeight(&Payload{Input: PayloadArgs{Height: 10}})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+}
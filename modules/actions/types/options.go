@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// handlerOptions contains the options used while registering a handler
+type handlerOptions struct {
+	// immutable tells whether the responses for an explicit height never change,
+	// and can be cached without expiration
+	immutable bool
+
+	// batch tells whether the handler is keyed by address, and a batch variant of it must be registered
+	batch bool
+
+	// args and response describe the arguments accepted and the response returned by the handler,
+	// and are used to document it
+	args     *ArgsSpec
+	response reflect.Type
+}
+
+// HandlerOption represents an option used while registering a handler
+type HandlerOption func(options *handlerOptions)
+
+// Immutable marks the responses of the handler for an explicit height as never changing, so that they are
+// cached without expiration. It must only be used by the handlers reading the data from the node, as the
+// data indexed inside the database might be stored or fixed after the response has been cached.
+func Immutable() HandlerOption {
+	return func(options *handlerOptions) {
+		options.immutable = true
+	}
+}
+
+// Batch registers a batch variant of the handler, accepting a list of addresses, on the same path with the
+// BatchPathSuffix suffix. It must only be used by the handlers reading a single address from the payload.
+func Batch() HandlerOption {
+	return func(options *handlerOptions) {
+		options.batch = true
+	}
+}
+
+// Args sets the arguments accepted by the handler
+func Args(spec ArgsSpec) HandlerOption {
+	return func(options *handlerOptions) {
+		options.args = &spec
+	}
+}
+
+// Response sets the type of the response returned by the handler, which is the type of the given value
+func Response(response interface{}) HandlerOption {
+	return func(options *handlerOptions) {
+		options.response = reflect.TypeOf(response)
+	}
+}
+
+// newHandlerOptions returns the handlerOptions built applying the given options
+func newHandlerOptions(opts []HandlerOption) (handlerOptions, error) {
+	var options handlerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.args != nil {
+		if err := options.args.Validate(); err != nil {
+			return options, fmt.Errorf("invalid arguments: %s", err)
+		}
+	}
+
+	return options, nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Cursor represents the position from which the next page of a paginated action should be read.
+// It contains the height at which the previous page has been read, so that walking through all the
+// pages always returns a consistent view of the chain state.
+type Cursor struct {
+	Height int64  `json:"h"`
+	Key    []byte `json:"k"`
+}
+
+// NewCursor returns a new Cursor instance
+func NewCursor(height int64, key []byte) Cursor {
+	return Cursor{
+		Height: height,
+		Key:    key,
+	}
+}
+
+// Encode returns the opaque string representation of the cursor
+func (c Cursor) Encode() string {
+	bz, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bz)
+}
+
+// DecodeCursor parses the given opaque string into a Cursor
+func DecodeCursor(value string) (Cursor, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %s", err)
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(bz, &cursor)
+	if err != nil || len(cursor.Key) == 0 {
+		return Cursor{}, fmt.Errorf("invalid cursor")
+	}
+
+	return cursor, nil
+}
+
+// Pagination contains the pagination data returned by the paginated actions
+type Pagination struct {
+	NextKey []byte `json:"next_key,omitempty"`
+	Total   uint64 `json:"total,omitempty"`
+
+	// Cursor should be sent back as the cursor argument to get the next page.
+	// It is empty when there are no more pages.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// NewPagination returns a new Pagination instance built from the given response read at the given height
+func NewPagination(res *query.PageResponse, height int64) *Pagination {
+	if res == nil {
+		return nil
+	}
+
+	pagination := &Pagination{
+		NextKey: res.NextKey,
+		Total:   res.Total,
+	}
+
+	if len(res.NextKey) > 0 {
+		pagination.Cursor = NewCursor(height, res.NextKey).Encode()
+	}
+
+	return pagination
+}
@@ -13,13 +13,39 @@ func (p *Payload) GetAddress() string {
 	return p.Input.Address
 }
 
-// GetPagination returns the pagination asasociated with this payload, if any
-func (p *Payload) GetPagination() *query.PageRequest {
+// GetPagination returns the pagination asasociated with this payload, if any.
+// When a cursor is given, the page is read starting from its key instead of the offset.
+func (p *Payload) GetPagination() (*query.PageRequest, error) {
+	if p.Input.Cursor == "" {
+		return &query.PageRequest{
+			Offset:     p.Input.Offset,
+			Limit:      p.Input.Limit,
+			CountTotal: p.Input.CountTotal,
+		}, nil
+	}
+
+	cursor, err := DecodeCursor(p.Input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	return &query.PageRequest{
-		Offset:     p.Input.Offset,
-		Limit:      p.Input.Limit,
-		CountTotal: p.Input.CountTotal,
+		Key:   cursor.Key,
+		Limit: p.Input.Limit,
+	}, nil
+}
+
+// GetCursorHeight returns the height stored inside the cursor of this payload, if any
+func (p *Payload) GetCursorHeight() int64 {
+	if p.Input.Cursor == "" {
+		return 0
 	}
+
+	cursor, err := DecodeCursor(p.Input.Cursor)
+	if err != nil {
+		return 0
+	}
+	return cursor.Height
 }
 
 type PayloadArgs struct {
@@ -28,4 +54,16 @@ type PayloadArgs struct {
 	Offset     uint64 `json:"offset"`
 	Limit      uint64 `json:"limit"`
 	CountTotal bool   `json:"count_total"`
+	Cursor     string `json:"cursor"`
+
+	Sender   string   `json:"sender"`
+	Receiver string   `json:"receiver"`
+	Amount   string   `json:"amount"`
+	Denom    string   `json:"denom"`
+	MsgTypes []string `json:"msg_types"`
+	Roles    []string `json:"roles"`
+
+	Addresses []string `json:"addresses"`
+
+	Days uint64 `json:"days"`
 }
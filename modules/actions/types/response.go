@@ -4,6 +4,8 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	feeexcludertypes "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	staketypes "git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtype "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -39,14 +41,18 @@ type Address struct {
 // ========================= Account Balance Response =========================
 
 type Balance struct {
+	DataSource
+
 	Coins []Coin `json:"coins"`
 }
 
 // ========================= Delegation Response =========================
 
 type DelegationResponse struct {
-	Delegations []Delegation        `json:"delegations"`
-	Pagination  *query.PageResponse `json:"pagination"`
+	DataSource
+
+	Delegations []Delegation `json:"delegations"`
+	Pagination  *Pagination  `json:"pagination"`
 }
 
 type Delegation struct {
@@ -65,14 +71,18 @@ type DelegationReward struct {
 // ========================= Validator Commission Response =========================
 
 type ValidatorCommissionAmount struct {
+	DataSource
+
 	Coins []Coin `json:"coins"`
 }
 
 // ========================= Unbonding Delegation Response =========================
 
 type UnbondingDelegationResponse struct {
+	DataSource
+
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations"`
-	Pagination           *query.PageResponse   `json:"pagination"`
+	Pagination           *Pagination           `json:"pagination"`
 }
 
 type UnbondingDelegation struct {
@@ -84,8 +94,10 @@ type UnbondingDelegation struct {
 // ========================= Redelegation Response =========================
 
 type RedelegationResponse struct {
-	Redelegations []Redelegation      `json:"redelegations"`
-	Pagination    *query.PageResponse `json:"pagination"`
+	DataSource
+
+	Redelegations []Redelegation `json:"redelegations"`
+	Pagination    *Pagination    `json:"pagination"`
 }
 
 type Redelegation struct {
@@ -99,3 +111,145 @@ type RedelegationEntry struct {
 	CompletionTime time.Time   `json:"completion_time"`
 	Balance        sdkmath.Int `json:"balance"`
 }
+
+// ========================= Stake Reward History Response =========================
+
+type StakeRewardHistoryResponse struct {
+	Entries []StakeRewardEntry `json:"entries"`
+}
+
+type StakeRewardEntry struct {
+	TxHash    string    `json:"tx_hash"`
+	Height    int64     `json:"height"`
+	Kind      string    `json:"kind"`
+	Coin      Coin      `json:"coin"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ========================= System Stake Response =========================
+
+type SystemStakeResponse struct {
+	Height   int64                `json:"height"`
+	Accounts []SystemStakeAccount `json:"accounts"`
+	Totals   []SystemStakeTotal   `json:"totals"`
+}
+
+type SystemStakeAccount struct {
+	Address       string `json:"address"`
+	Kind          string `json:"kind"`
+	Active        bool   `json:"active"`
+	CreatedHeight int64  `json:"created_height"`
+	UpdatedHeight int64  `json:"updated_height"`
+}
+
+type SystemStakeTotal struct {
+	Kind    string      `json:"kind"`
+	Address string      `json:"address"`
+	Total   sdkmath.Int `json:"total"`
+	Height  int64       `json:"height"`
+}
+
+// ========================= Stake Transfer Flows Response =========================
+
+type StakeTransferFlowsResponse struct {
+	Daily          []StakeDailyFlow        `json:"daily"`
+	Counterparties []StakeCounterpartyFlow `json:"counterparties"`
+	Total          *StakeTotalFlow         `json:"total"`
+}
+
+type StakeDailyFlow struct {
+	Date string      `json:"date"`
+	In   sdkmath.Int `json:"in"`
+	Out  sdkmath.Int `json:"out"`
+	Net  sdkmath.Int `json:"net"`
+}
+
+type StakeCounterpartyFlow struct {
+	Counterparty string      `json:"counterparty"`
+	In           sdkmath.Int `json:"in"`
+	Out          sdkmath.Int `json:"out"`
+	Transfers    uint64      `json:"transfers"`
+}
+
+type StakeTotalFlow struct {
+	In     sdkmath.Int `json:"in"`
+	Out    sdkmath.Int `json:"out"`
+	Net    sdkmath.Int `json:"net"`
+	Height int64       `json:"height"`
+}
+
+// ========================= Address Activity Response =========================
+
+type AddressActivityResponse struct {
+	Activities []AddressActivity   `json:"activities"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
+type AddressActivity struct {
+	TxHash    string    `json:"tx_hash"`
+	MsgIndex  int       `json:"msg_index"`
+	Height    int64     `json:"height"`
+	Role      string    `json:"role"`
+	Module    string    `json:"module"`
+	MsgType   string    `json:"msg_type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ========================= OverGold Stakes Response =========================
+
+type OvgStakesResponse struct {
+	DataSource
+
+	Stakes []*staketypes.Stake `json:"stakes"`
+}
+
+// ========================= OverGold Fees Response =========================
+
+type OvgFeesResponse struct {
+	DataSource
+
+	Tariffs []OvgTariffFees `json:"tariffs"`
+}
+
+// OvgTariffFees contains the fees of a single tariff of a denom
+type OvgTariffFees struct {
+	Denom    string                   `json:"denom"`
+	TariffID uint64                   `json:"tariff_id"`
+	Fees     []*feeexcludertypes.Fees `json:"fees"`
+}
+
+// ========================= OverGold Referrer Response =========================
+
+type OvgReferrerResponse struct {
+	DataSource
+
+	Address  string `json:"address"`
+	Referrer string `json:"referrer"`
+}
+
+// ========================= OverGold Is Allowed Response =========================
+
+type OvgIsAllowedResponse struct {
+	DataSource
+
+	Address string `json:"address"`
+	Allowed bool   `json:"allowed"`
+}
+
+// ========================= OverGold Fee Quote Response =========================
+
+type OvgFeeQuoteResponse struct {
+	Sender       string      `json:"sender"`
+	Receiver     string      `json:"receiver"`
+	Denom        string      `json:"denom"`
+	Amount       sdkmath.Int `json:"amount"`
+	Excluded     bool        `json:"excluded"`
+	TariffID     uint64      `json:"tariff_id"`
+	FeesID       uint64      `json:"fees_id"`
+	Referrer     string      `json:"referrer"`
+	Fee          sdkmath.Int `json:"fee"`
+	RefReward    sdkmath.Int `json:"ref_reward"`
+	StakeReward  sdkmath.Int `json:"stake_reward"`
+	SystemReward sdkmath.Int `json:"system_reward"`
+	Total        sdkmath.Int `json:"total"`
+}
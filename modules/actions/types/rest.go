@@ -0,0 +1,273 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// OpenAPIPath represents the path, relative to the REST prefix, on which the OpenAPI document is served
+const OpenAPIPath = "/openapi.json"
+
+// EnableREST exposes each handler registered after this call as a GET endpoint under the given prefix,
+// reading the payload arguments from the query parameters. The OpenAPI document describing all the
+// endpoints is served under the same prefix.
+func (w *ActionsWorker) EnableREST(prefix string) {
+	w.restPrefix = strings.TrimSuffix(prefix, "/")
+	w.mux.HandleFunc(w.restPrefix+OpenAPIPath, w.handleOpenAPI)
+}
+
+// readRESTPayload reads the payload arguments from the query parameters of the given request
+func readRESTPayload(request *http.Request) (*Payload, error) {
+	if request.Method != http.MethodGet {
+		return nil, &payloadError{status: http.StatusMethodNotAllowed, message: "method not allowed"}
+	}
+
+	args, err := parseQueryArgs(request.URL.Query())
+	if err != nil {
+		return nil, &payloadError{status: http.StatusBadRequest, message: err.Error()}
+	}
+
+	return &Payload{Input: args}, nil
+}
+
+// parseQueryArgs builds the PayloadArgs setting each field from the query parameter named as its json tag.
+// List fields accept both repeated parameters and comma separated values.
+func parseQueryArgs(values url.Values) (PayloadArgs, error) {
+	var args PayloadArgs
+	argsValue := reflect.ValueOf(&args).Elem()
+
+	for _, field := range payloadArgsFields() {
+		raw, ok := values[field.name]
+		if !ok || len(raw) == 0 {
+			continue
+		}
+
+		target := argsValue.Field(field.index)
+		switch target.Kind() {
+		case reflect.String:
+			target.SetString(raw[0])
+
+		case reflect.Int, reflect.Int64:
+			value, err := strconv.ParseInt(raw[0], 10, 64)
+			if err != nil {
+				return args, fmt.Errorf("invalid %s: %s", field.name, raw[0])
+			}
+			target.SetInt(value)
+
+		case reflect.Uint, reflect.Uint64:
+			value, err := strconv.ParseUint(raw[0], 10, 64)
+			if err != nil {
+				return args, fmt.Errorf("invalid %s: %s", field.name, raw[0])
+			}
+			target.SetUint(value)
+
+		case reflect.Bool:
+			value, err := strconv.ParseBool(raw[0])
+			if err != nil {
+				return args, fmt.Errorf("invalid %s: %s", field.name, raw[0])
+			}
+			target.SetBool(value)
+
+		case reflect.Slice:
+			var list []string
+			for _, value := range raw {
+				for _, item := range strings.Split(value, ",") {
+					if item = strings.TrimSpace(item); item != "" {
+						list = append(list, item)
+					}
+				}
+			}
+			target.Set(reflect.ValueOf(list))
+		}
+	}
+
+	return args, nil
+}
+
+// payloadArgsField contains the data about a single PayloadArgs field exposed as a query parameter
+type payloadArgsField struct {
+	index int
+	name  string
+	kind  reflect.Kind
+}
+
+// payloadArgsFields returns the PayloadArgs fields that can be set using the query parameters
+func payloadArgsFields() []payloadArgsField {
+	argsType := reflect.TypeOf(PayloadArgs{})
+
+	var fields []payloadArgsField
+	for i := 0; i < argsType.NumField(); i++ {
+		field := argsType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fields = append(fields, payloadArgsField{index: i, name: name, kind: field.Type.Kind()})
+	}
+	return fields
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// handleOpenAPI serves the OpenAPI document describing all the registered REST endpoints
+func (w *ActionsWorker) handleOpenAPI(writer http.ResponseWriter, _ *http.Request) {
+	bz, err := json.Marshal(w.openAPIDocument())
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Write(bz)
+}
+
+// openAPIDocument builds the OpenAPI document describing the registered REST endpoints
+func (w *ActionsWorker) openAPIDocument() map[string]interface{} {
+	paths := make(map[string]interface{}, len(w.endpoints))
+	for _, endpoint := range w.sortedEndpoints() {
+		paths[w.restPrefix+endpoint.path] = map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": strings.TrimPrefix(endpoint.path, "/"),
+				"parameters":  openAPIParameters(endpoint),
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "Action response",
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{
+								"schema": openAPIResponseSchema(endpoint),
+							},
+						},
+					},
+					"400": map[string]interface{}{
+						"description": "Action error",
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{
+								"schema": map[string]interface{}{"$ref": "#/components/schemas/Error"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "BDJuno actions",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"Error": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"message": map[string]interface{}{"type": "string"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// openAPIParameters returns the query parameters accepted by the given endpoint.
+// Endpoints registered without arguments are described as accepting all the PayloadArgs fields.
+func openAPIParameters(endpoint endpoint) []map[string]interface{} {
+	fields := make(map[string]payloadArgsField)
+	for _, field := range payloadArgsFields() {
+		fields[field.name] = field
+	}
+
+	spec := ArgsSpec{}
+	switch {
+	case endpoint.options.args == nil:
+		for _, field := range payloadArgsFields() {
+			spec.Optional = append(spec.Optional, field.name)
+		}
+	case endpoint.batch:
+		spec = endpoint.options.args.batch()
+	default:
+		spec = *endpoint.options.args
+	}
+
+	parameters := make([]map[string]interface{}, 0, len(spec.Required)+len(spec.Optional))
+	addParameters := func(names []string, required bool) {
+		for _, name := range names {
+			field := fields[name]
+			parameters = append(parameters, map[string]interface{}{
+				"name":     field.name,
+				"in":       "query",
+				"required": required,
+				"schema":   openAPISchema(field.kind),
+				"explode":  field.kind == reflect.Slice,
+			})
+		}
+	}
+	addParameters(spec.Required, true)
+	addParameters(spec.Optional, false)
+
+	return parameters
+}
+
+// openAPIResponseSchema returns the schema of the response returned by the given endpoint.
+// Endpoints registered without a response type are described as returning any object.
+func openAPIResponseSchema(endpoint endpoint) map[string]interface{} {
+	result := map[string]interface{}{"type": "object"}
+	if endpoint.options.response != nil {
+		result = jsonSchema(endpoint.options.response)
+	}
+
+	if !endpoint.batch {
+		return result
+	}
+
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"results": map[string]interface{}{
+				"type": "object",
+				"additionalProperties": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"result": result,
+						"error":  map[string]interface{}{"type": "string"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sortedEndpoints returns the registered endpoints sorted alphabetically by path
+func (w *ActionsWorker) sortedEndpoints() []endpoint {
+	endpoints := make([]endpoint, len(w.endpoints))
+	copy(endpoints, w.endpoints)
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].path < endpoints[j].path
+	})
+	return endpoints
+}
+
+// openAPISchema returns the OpenAPI schema of a query parameter having the given kind
+func openAPISchema(kind reflect.Kind) map[string]interface{} {
+	switch kind {
+	case reflect.Int, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Uint, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}}
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
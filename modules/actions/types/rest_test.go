@@ -0,0 +1,71 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsWorker_OpenAPIDocument(t *testing.T) {
+	handler := func(*Context, *Payload) (interface{}, error) { return nil, nil }
+
+	worker := NewActionsWorker(nil)
+	worker.EnableREST("/api")
+	worker.RegisterHandler("/balance", handler, Batch(),
+		Args(ArgsSpec{Required: []string{"address"}, Optional: []string{"height"}}), Response(Balance{}))
+
+	bz, err := json.Marshal(worker.openAPIDocument())
+	require.NoError(t, err)
+
+	var document struct {
+		Paths map[string]struct {
+			Get struct {
+				Parameters []struct {
+					Name     string `json:"name"`
+					Required bool   `json:"required"`
+				} `json:"parameters"`
+				Responses map[string]struct {
+					Content map[string]struct {
+						Schema json.RawMessage `json:"schema"`
+					} `json:"content"`
+				} `json:"responses"`
+			} `json:"get"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &document))
+	require.Len(t, document.Paths, 2)
+
+	single := document.Paths["/api/balance"].Get
+	require.Len(t, single.Parameters, 2)
+	require.Equal(t, "address", single.Parameters[0].Name)
+	require.True(t, single.Parameters[0].Required)
+	require.Equal(t, "height", single.Parameters[1].Name)
+	require.False(t, single.Parameters[1].Required)
+	require.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"source": {"type": "string"},
+			"height": {"type": "integer", "format": "int64"},
+			"coins": {"type": "array", "items": {"type": "object", "properties": {
+				"amount": {"type": "string"},
+				"denom": {"type": "string"}
+			}}}
+		}
+	}`, string(single.Responses["200"].Content["application/json"].Schema))
+
+	batch := document.Paths["/api/balance_batch"].Get
+	require.Len(t, batch.Parameters, 2)
+	require.Equal(t, "addresses", batch.Parameters[0].Name)
+	require.True(t, batch.Parameters[0].Required)
+	require.Equal(t, "height", batch.Parameters[1].Name)
+}
+
+func TestActionsWorker_RegisterHandler_InvalidArgs(t *testing.T) {
+	handler := func(*Context, *Payload) (interface{}, error) { return nil, nil }
+
+	worker := NewActionsWorker(nil)
+	require.Panics(t, func() {
+		worker.RegisterHandler("/balance", handler, Args(ArgsSpec{Required: []string{"unknown"}}))
+	})
+}
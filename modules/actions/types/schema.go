@@ -0,0 +1,153 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ArgsSpec describes the payload arguments accepted by a handler, named as the PayloadArgs json tags
+type ArgsSpec struct {
+	Required []string
+	Optional []string
+}
+
+// Validate checks that all the arguments are PayloadArgs fields, and that none of them is repeated
+func (s ArgsSpec) Validate() error {
+	known := make(map[string]bool)
+	for _, field := range payloadArgsFields() {
+		known[field.name] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range append(append([]string{}, s.Required...), s.Optional...) {
+		if !known[name] {
+			return fmt.Errorf("unknown argument %s", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicated argument %s", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+// batch returns the ArgsSpec of the batch variant of the handler accepting these arguments,
+// which requires a list of addresses instead of a single one
+func (s ArgsSpec) batch() ArgsSpec {
+	spec := ArgsSpec{Required: []string{"addresses"}}
+	for _, name := range s.Required {
+		if name != "address" {
+			spec.Required = append(spec.Required, name)
+		}
+	}
+	for _, name := range s.Optional {
+		if name != "address" {
+			spec.Optional = append(spec.Optional, name)
+		}
+	}
+	return spec
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// jsonSchema returns the OpenAPI schema describing the JSON encoding of the values having the given type
+func jsonSchema(t reflect.Type) map[string]interface{} {
+	return typeSchema(t, make(map[reflect.Type]bool))
+}
+
+// typeSchema returns the schema of the given type, using the given set of types being described
+// to avoid an infinite recursion on recursive types
+func typeSchema(t reflect.Type, visiting map[reflect.Type]bool) map[string]interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+
+	case t.Implements(marshalerType) || reflect.PointerTo(t).Implements(marshalerType):
+		// Custom encodings are used by the numeric types, which are encoded as strings
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem(), visiting)}
+
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": typeSchema(t.Elem(), visiting)}
+
+	case reflect.Struct:
+		if visiting[t] {
+			return map[string]interface{}{"type": "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		properties := make(map[string]interface{})
+		addStructProperties(t, properties, visiting)
+		return map[string]interface{}{"type": "object", "properties": properties}
+
+	default:
+		// Interfaces can hold any value
+		return map[string]interface{}{}
+	}
+}
+
+// addStructProperties adds the schemas of the fields of the given struct type to the given properties,
+// flattening the embedded structs as the JSON encoding does
+func addStructProperties(t reflect.Type, properties map[string]interface{}, visiting map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addStructProperties(embedded, properties, visiting)
+				continue
+			}
+		}
+
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = typeSchema(field.Type, visiting)
+	}
+}
@@ -1,10 +1,15 @@
 package types
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/forbole/bdjuno/v4/modules/actions/logging"
@@ -12,47 +17,183 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// Middleware wraps the handler registered for the given path
+type Middleware func(path string, next http.HandlerFunc) http.HandlerFunc
+
 // ActionsWorker represents the worker that is used to handle Hasura actions queries
 type ActionsWorker struct {
-	mux     *http.ServeMux
-	context *Context
+	mux         *http.ServeMux
+	context     *Context
+	middlewares []Middleware
+	cache       *ResponseCache
+	restPrefix  string
+	endpoints   []endpoint
+	fallbacks   map[string]bool
+
+	batchMaxAddresses int
+	batchConcurrency  int
+
+	mu     sync.Mutex
+	server *http.Server
+	ready  atomic.Bool
 }
 
 // NewActionsWorker returns a new ActionsWorker instance
 func NewActionsWorker(context *Context) *ActionsWorker {
-	return &ActionsWorker{
-		mux:     http.NewServeMux(),
-		context: context,
+	worker := &ActionsWorker{
+		mux:               http.NewServeMux(),
+		context:           context,
+		fallbacks:         make(map[string]bool),
+		batchMaxAddresses: DefaultBatchMaxAddresses,
+		batchConcurrency:  DefaultBatchConcurrency,
 	}
+
+	// Probes are not wrapped by the middlewares so that they are never rejected
+	worker.mux.HandleFunc(LivenessPath, worker.handleLiveness)
+	worker.mux.HandleFunc(ReadinessPath, worker.handleReadiness)
+
+	return worker
+}
+
+// Use adds the given middlewares to the ones wrapping each handler.
+// Middlewares are applied in the given order, and only to the handlers registered after this call.
+func (w *ActionsWorker) Use(middlewares ...Middleware) {
+	w.middlewares = append(w.middlewares, middlewares...)
+}
+
+// SetCache sets the cache used to store the handlers responses
+func (w *ActionsWorker) SetCache(cache *ResponseCache) {
+	w.cache = cache
 }
 
-// RegisterHandler registers the provided handler to be used on each call to the provided path
-func (w *ActionsWorker) RegisterHandler(path string, handler ActionHandler) {
+// RegisterHandler registers the provided handler to be used on each call to the provided path,
+// along with its batch variant if the Batch option is given.
+// It panics if the given options are not valid.
+func (w *ActionsWorker) RegisterHandler(path string, handler ActionHandler, opts ...HandlerOption) {
+	options, err := newHandlerOptions(opts)
+	if err != nil {
+		panic(fmt.Errorf("error while registering handler %s: %s", path, err))
+	}
+
+	w.registerHandler(path, handler, options)
+	w.endpoints = append(w.endpoints, endpoint{path: path, options: options})
+
+	if options.batch {
+		w.registerHandler(path+BatchPathSuffix, w.batchHandler(handler), options)
+		w.endpoints = append(w.endpoints, endpoint{path: path + BatchPathSuffix, options: options, batch: true})
+	}
+}
+
+// registerHandler registers the provided handler on the given path, wrapping it with the middlewares.
+// When the REST front-end is enabled, the handler is exposed on the REST prefix as well.
+func (w *ActionsWorker) registerHandler(path string, handler ActionHandler, options handlerOptions) {
 	log.Debug().Str("action", path).Msg("registering actions handler")
-	w.mux.HandleFunc(path, func(writer http.ResponseWriter, request *http.Request) {
+
+	w.mux.HandleFunc(path, w.wrap(path, w.handlerFunc(path, handler, options, readHasuraPayload)))
+
+	if w.restPrefix != "" {
+		w.mux.HandleFunc(w.restPrefix+path, w.wrap(path, w.handlerFunc(path, handler, options, readRESTPayload)))
+	}
+}
+
+// endpoint contains the data about a registered handler, used to document it
+type endpoint struct {
+	path    string
+	options handlerOptions
+
+	// batch tells whether the handler is the batch variant of the one registered with the same options
+	batch bool
+}
+
+// wrap wraps the given handler with the registered middlewares
+func (w *ActionsWorker) wrap(path string, handlerFunc http.HandlerFunc) http.HandlerFunc {
+	for i := len(w.middlewares) - 1; i >= 0; i-- {
+		handlerFunc = w.middlewares[i](path, handlerFunc)
+	}
+	return handlerFunc
+}
+
+// payloadError represents an error that occurred while reading the payload of a request
+type payloadError struct {
+	status  int
+	message string
+}
+
+func (e *payloadError) Error() string {
+	return e.message
+}
+
+// payloadReader reads the actions payload from the given request
+type payloadReader func(request *http.Request) (*Payload, error)
+
+// readHasuraPayload reads the payload sent by Hasura inside the request body
+func readHasuraPayload(request *http.Request) (*Payload, error) {
+	// Read the body
+	reqBody, err := io.ReadAll(request.Body)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return nil, &payloadError{status: http.StatusRequestEntityTooLarge, message: "payload too large"}
+	}
+	if err != nil {
+		return nil, &payloadError{status: http.StatusBadRequest, message: "invalid payload"}
+	}
+	defer request.Body.Close()
+
+	// Get the actions payload
+	var payload Payload
+	err = json.Unmarshal(reqBody, &payload)
+	if err != nil {
+		return nil, &payloadError{status: http.StatusInternalServerError, message: "invalid payload: failed to unmarshal json"}
+	}
+
+	return &payload, nil
+}
+
+// handlerFunc returns the http.HandlerFunc that executes the given handler on the payload read using the given reader
+func (w *ActionsWorker) handlerFunc(
+	path string, handler ActionHandler, options handlerOptions, readPayload payloadReader,
+) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
 		start := time.Now()
 
 		// Set the content type
 		writer.Header().Set("Content-Type", "application/json")
 
-		// Read the body
-		reqBody, err := io.ReadAll(request.Body)
+		// Read the payload
+		payload, err := readPayload(request)
 		if err != nil {
-			http.Error(writer, "invalid payload", http.StatusBadRequest)
+			var payloadErr *payloadError
+			if !errors.As(err, &payloadErr) {
+				payloadErr = &payloadError{status: http.StatusBadRequest, message: err.Error()}
+			}
+
+			if payloadErr.status == http.StatusRequestEntityTooLarge || payloadErr.status == http.StatusMethodNotAllowed {
+				RejectRequest(writer, path, payloadErr.status, payloadErr.message)
+				return
+			}
+
+			http.Error(writer, payloadErr.message, payloadErr.status)
 			return
 		}
-		defer request.Body.Close()
 
-		// Get the actions payload
-		var payload Payload
-		err = json.Unmarshal(reqBody, &payload)
-		if err != nil {
-			http.Error(writer, "invalid payload: failed to unmarshal json", http.StatusInternalServerError)
-			return
+		// Serve the response from the cache, if possible
+		if w.cache != nil {
+			if data, found := w.cache.Get(path, payload); found {
+				logging.SuccessCounter(path)
+				logging.ReponseTimeBuckets(path, start)
+
+				writer.Write(data)
+				return
+			}
 		}
 
-		// Handle the request
-		res, err := handler(w.context, &payload)
+		// Handle the request, cancelling the node queries once the request is cancelled or times out
+		res, err := handler(w.context.WithContext(request.Context()), payload)
 		if err != nil {
 			logging.ErrorCounter(path)
 			w.handleError(writer, path, err)
@@ -67,13 +208,46 @@ func (w *ActionsWorker) RegisterHandler(path string, handler ActionHandler) {
 			return
 		}
 
+		if w.cache != nil && cacheable(res) {
+			w.cache.Set(path, payload, data, options.immutable)
+		}
+
 		// Prometheus
 		logging.SuccessCounter(path)
 		logging.ReponseTimeBuckets(path, start)
 
 		// Write the response
 		writer.Write(data)
-	})
+	}
+}
+
+// cacheable tells whether the given response can be cached. Responses read from the database are not cached,
+// as they might not reflect the requested height, and neither are the ones containing errors, which might be
+// transient.
+func cacheable(res interface{}) bool {
+	if fallback, ok := res.(fallbackResponse); ok && fallback.IsFallback() {
+		return false
+	}
+
+	if partial, ok := res.(partialResponse); ok && partial.HasErrors() {
+		return false
+	}
+
+	return true
+}
+
+// RejectRequest writes the given rejection to the provided writer, counting it in the metrics
+func RejectRequest(writer http.ResponseWriter, path string, status int, message string) {
+	logging.RejectedCounter(path, status)
+
+	errorBody, err := json.Marshal(GraphQLError{Message: message})
+	if err != nil {
+		panic(err)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	writer.Write(errorBody)
 }
 
 // handleError allows to handle the given error by writing it to the provided writer
@@ -93,17 +267,67 @@ func (w *ActionsWorker) handleError(writer http.ResponseWriter, path string, err
 	writer.Write(errorBody)
 }
 
-// Start starts the worker
-func (w *ActionsWorker) Start(host string, port uint) {
-	server := &http.Server{
-		Addr:              fmt.Sprintf("%s:%d", host, port),
+// Start starts the worker, blocking until it is shut down or the server fails
+func (w *ActionsWorker) Start(host string, port uint) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", host, port))
+	if err != nil {
+		return fmt.Errorf("error while listening on %s:%d: %s", host, port, err)
+	}
+
+	w.mu.Lock()
+	w.server = &http.Server{
 		Handler:           w.mux,
 		ReadHeaderTimeout: 3 * time.Second,
 	}
+	server := w.server
+	w.mu.Unlock()
+
+	w.ready.Store(true)
+	log.Info().Str("address", listener.Addr().String()).Msg("actions worker started")
+
+	err = server.Serve(listener)
+	w.ready.Store(false)
+
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error while serving actions: %s", err)
+	}
+	return nil
+}
 
-	err := server.ListenAndServe()
+// Shutdown stops accepting new requests and waits for the in-flight ones to complete
+// until the given context expires
+func (w *ActionsWorker) Shutdown(ctx context.Context) error {
+	w.ready.Store(false)
 
+	w.mu.Lock()
+	server := w.server
+	w.mu.Unlock()
+
+	if server == nil {
+		return nil
+	}
+
+	err := server.Shutdown(ctx)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("error while shutting down actions worker: %s", err)
+	}
+	return nil
+}
+
+// handleLiveness replies to the liveness probes, telling that the process is running
+func (w *ActionsWorker) handleLiveness(writer http.ResponseWriter, _ *http.Request) {
+	writer.WriteHeader(http.StatusOK)
+	writer.Write([]byte("ok"))
+}
+
+// handleReadiness replies to the readiness probes, telling whether the worker is accepting requests
+func (w *ActionsWorker) handleReadiness(writer http.ResponseWriter, _ *http.Request) {
+	if !w.ready.Load() {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		writer.Write([]byte("not ready"))
+		return
 	}
+
+	writer.WriteHeader(http.StatusOK)
+	writer.Write([]byte("ok"))
 }
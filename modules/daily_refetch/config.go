@@ -0,0 +1,110 @@
+package daily_refetch
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DefaultSchedule    = "0 0 * * *"
+	DefaultConcurrency = 4
+	DefaultLookback    = 24 * time.Hour
+)
+
+// Config contains the configuration about the daily refetch module
+type Config struct {
+	// Schedule is the cron expression telling when the windows not setting their own schedule are scanned
+	Schedule string `yaml:"schedule,omitempty"`
+	// Concurrency is the maximum number of missing blocks refetched at the same time
+	Concurrency int `yaml:"concurrency,omitempty"`
+	// Windows contains the ranges of heights that are scanned for missing blocks
+	Windows []WindowConfig `yaml:"windows,omitempty"`
+}
+
+// WindowConfig contains the configuration of a range of heights scanned for missing blocks.
+// Exactly one of Lookback, Blocks and Full must be set.
+type WindowConfig struct {
+	Name string `yaml:"name"`
+	// Schedule is the cron expression telling when the window is scanned, overriding the module one
+	Schedule string `yaml:"schedule,omitempty"`
+	// Lookback makes the window start at the block stored this long ago
+	Lookback time.Duration `yaml:"lookback,omitempty"`
+	// Blocks makes the window start this many blocks before the latest one
+	Blocks int64 `yaml:"blocks,omitempty"`
+	// Full makes the window start at the start height inside the parser config
+	Full bool `yaml:"full,omitempty"`
+}
+
+// DefaultConfig returns the configuration used when the daily refetch section is not set,
+// which scans the last day of blocks every midnight
+func DefaultConfig() *Config {
+	cfg := &Config{}
+	_ = cfg.Validate()
+	return cfg
+}
+
+// Validate checks that the windows are valid, setting the default values of the fields that are not set
+func (cfg *Config) Validate() error {
+	if cfg.Schedule == "" {
+		cfg.Schedule = DefaultSchedule
+	}
+
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultConcurrency
+	}
+
+	if len(cfg.Windows) == 0 {
+		cfg.Windows = []WindowConfig{{Name: "day", Lookback: DefaultLookback}}
+	}
+
+	names := make(map[string]bool, len(cfg.Windows))
+	for i, window := range cfg.Windows {
+		if window.Name == "" {
+			return fmt.Errorf("daily refetch window %d has no name", i)
+		}
+
+		if names[window.Name] {
+			return fmt.Errorf("duplicated daily refetch window %s", window.Name)
+		}
+		names[window.Name] = true
+
+		ranges := 0
+		if window.Lookback > 0 {
+			ranges++
+		}
+		if window.Blocks > 0 {
+			ranges++
+		}
+		if window.Full {
+			ranges++
+		}
+		if ranges != 1 {
+			return fmt.Errorf("daily refetch window %s must set exactly one of lookback, blocks and full", window.Name)
+		}
+
+		if window.Schedule == "" {
+			cfg.Windows[i].Schedule = cfg.Schedule
+		}
+	}
+
+	return nil
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"daily_refetch"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	return cfg.Config, cfg.Config.Validate()
+}
@@ -0,0 +1,60 @@
+package daily_refetch_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dailyrefetch "github.com/forbole/bdjuno/v4/modules/daily_refetch"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := dailyrefetch.ParseConfig([]byte(`chain: {}`))
+	require.NoError(t, err)
+	require.Equal(t, &dailyrefetch.Config{
+		Schedule:    dailyrefetch.DefaultSchedule,
+		Concurrency: dailyrefetch.DefaultConcurrency,
+		Windows: []dailyrefetch.WindowConfig{
+			{Name: "day", Schedule: dailyrefetch.DefaultSchedule, Lookback: dailyrefetch.DefaultLookback},
+		},
+	}, cfg)
+
+	cfg, err = dailyrefetch.ParseConfig([]byte(`
+daily_refetch:
+  schedule: "0 1 * * *"
+  concurrency: 8
+  windows:
+    - name: hour
+      lookback: 1h
+    - name: full
+      full: true
+      schedule: "0 3 * * 0"
+`))
+	require.NoError(t, err)
+	require.Equal(t, 8, cfg.Concurrency)
+	require.Equal(t, []dailyrefetch.WindowConfig{
+		{Name: "hour", Schedule: "0 1 * * *", Lookback: time.Hour},
+		{Name: "full", Schedule: "0 3 * * 0", Full: true},
+	}, cfg.Windows)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		windows []dailyrefetch.WindowConfig
+	}{
+		{name: "missing name", windows: []dailyrefetch.WindowConfig{{Blocks: 10}}},
+		{name: "duplicated name", windows: []dailyrefetch.WindowConfig{{Name: "a", Blocks: 10}, {Name: "a", Full: true}}},
+		{name: "no range", windows: []dailyrefetch.WindowConfig{{Name: "a"}}},
+		{name: "many ranges", windows: []dailyrefetch.WindowConfig{{Name: "a", Blocks: 10, Full: true}}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := dailyrefetch.Config{Windows: tc.windows}
+			require.Error(t, cfg.Validate())
+		})
+	}
+}
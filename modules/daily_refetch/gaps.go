@@ -0,0 +1,91 @@
+package daily_refetch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/forbole/juno/v5/parser"
+	"github.com/rs/zerolog/log"
+
+	bdjunodb "github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// gapHeight represents a single height to be refetched, along with the index of the gap containing it
+type gapHeight struct {
+	gap    int
+	height int64
+}
+
+// FillGaps refetches the heights of the given gaps, found while scanning the scanned range of heights,
+// running at most concurrency workers at the same time. The report of each gap is stored inside the database
+// and returned, along with an error if any height could not be refetched.
+func FillGaps(
+	ctx *parser.Context, db *bdjunodb.Db, source string, scanned types.BlockGap, gaps []types.BlockGap, concurrency int,
+) ([]types.BlockGapReport, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	missing := make([]int64, len(gaps))
+	firstErrors := make([]string, len(gaps))
+
+	heights := make(chan gapHeight)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+
+			worker := parser.NewWorker(ctx, nil, index)
+			for h := range heights {
+				err := worker.Process(h.height)
+				if err == nil {
+					continue
+				}
+
+				log.Error().Str("module", "daily refetch").Int64("height", h.height).Err(err).
+					Msg("error while re-fetching block")
+
+				mu.Lock()
+				missing[h.gap]++
+				if firstErrors[h.gap] == "" {
+					firstErrors[h.gap] = fmt.Sprintf("error while re-fetching block %d: %s", h.height, err)
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	var total int64
+	for i, gap := range gaps {
+		total += gap.Size()
+		for height := gap.StartHeight; height <= gap.EndHeight; height++ {
+			heights <- gapHeight{gap: i, height: height}
+		}
+	}
+	close(heights)
+	wg.Wait()
+
+	checkedAt := time.Now().UTC()
+	reports := make([]types.BlockGapReport, len(gaps))
+
+	var failed int64
+	for i, gap := range gaps {
+		reports[i] = types.NewBlockGapReport(gap, source, missing[i], firstErrors[i], checkedAt)
+		failed += missing[i]
+	}
+
+	err := db.SaveBlockGapReports(scanned, checkedAt, reports)
+	if err != nil {
+		return reports, err
+	}
+
+	if failed > 0 {
+		return reports, fmt.Errorf("%d of %d missing blocks could not be re-fetched", failed, total)
+	}
+
+	return reports, nil
+}
@@ -11,24 +11,33 @@ import (
 	"github.com/rs/zerolog/log"
 
 	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+
+	"github.com/forbole/bdjuno/v4/types"
 )
 
 func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 	log.Debug().Str("module", "daily refetch").Msg("setting up periodic tasks")
 
-	// Setup a cron job to run every midnight
-	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
-		m.refetchMissingBlocks()
-	}); err != nil {
-		return fmt.Errorf("error while setting up daily refetch periodic operation: %s", err)
+	// Setup a cron job for each window, skipping a run if the previous one has not finished yet
+	for _, window := range m.cfg.Windows {
+		window := window
+		if _, err := scheduler.Cron(window.Schedule).SingletonMode().Do(func() {
+			err := m.refetchMissingBlocks(window)
+			if err != nil {
+				log.Error().Str("module", "daily refetch").Str("window", window.Name).Err(err).
+					Msg("error while refetching missing blocks")
+			}
+		}); err != nil {
+			return fmt.Errorf("error while setting up daily refetch periodic operation for window %s: %s", window.Name, err)
+		}
 	}
 
 	return nil
 }
 
-// refetchMissingBlocks checks for missing blocks from one day ago and refetches them
-func (m *Module) refetchMissingBlocks() error {
-	log.Trace().Str("module", "daily refetch").Str("refetching", "blocks").
+// refetchMissingBlocks checks for missing blocks inside the given window and refetches them
+func (m *Module) refetchMissingBlocks(window WindowConfig) error {
+	log.Trace().Str("module", "daily refetch").Str("window", window.Name).Str("refetching", "blocks").
 		Msg("refetching missing blocks")
 
 	latestBlock, err := m.node.LatestHeight()
@@ -36,17 +45,20 @@ func (m *Module) refetchMissingBlocks() error {
 		return fmt.Errorf("error while getting latest block: %s", err)
 	}
 
-	blockHeightDayAgo, err := m.database.GetBlockHeightTimeDayAgo(time.Now())
+	startHeight, err := m.windowStartHeight(window, latestBlock)
 	if err != nil {
-		return fmt.Errorf("error while getting block height from a day ago: %s", err)
+		return err
 	}
-	var startHeight = blockHeightDayAgo.Height
 
-	missingBlocks := m.database.GetMissingBlocks(startHeight, latestBlock)
+	scanned := types.NewBlockGap(startHeight, latestBlock)
+	gaps, err := m.database.GetBlockGaps(scanned.StartHeight, scanned.EndHeight)
+	if err != nil {
+		return err
+	}
 
-	// return if no blocks are missing
-	if len(missingBlocks) == 0 {
-		return nil
+	// close the reports of the gaps filled in the meantime, and return if no blocks are missing
+	if len(gaps) == 0 {
+		return m.database.SaveBlockGapReports(scanned, time.Now().UTC(), nil)
 	}
 
 	parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parsecmdtypes.NewConfig())
@@ -55,17 +67,35 @@ func (m *Module) refetchMissingBlocks() error {
 	}
 
 	workerCtx := parser.NewContext(parseCtx.EncodingConfig, parseCtx.Node, parseCtx.Database, parseCtx.Logger, parseCtx.Modules)
-	worker := parser.NewWorker(workerCtx, nil, 0)
 
-	log.Info().Int64("start height", startHeight).Int64("end height", latestBlock).
-		Msg("getting missing blocks and transactions from a day ago")
-	for _, block := range missingBlocks {
-		err = worker.Process(block)
+	log.Info().Str("window", window.Name).Int64("start height", startHeight).Int64("end height", latestBlock).
+		Int("gaps", len(gaps)).Msg("getting missing blocks and transactions")
+
+	_, err = FillGaps(workerCtx, m.database, window.Name, scanned, gaps, m.cfg.Concurrency)
+	return err
+}
+
+// windowStartHeight returns the first height scanned by the given window
+func (m *Module) windowStartHeight(window WindowConfig, latestBlock int64) (int64, error) {
+	var startHeight int64
+	switch {
+	case window.Full:
+		startHeight = m.startHeight
+
+	case window.Blocks > 0:
+		startHeight = latestBlock - window.Blocks + 1
+
+	default:
+		height, err := m.database.GetBlockHeightBefore(time.Now().Add(-window.Lookback))
 		if err != nil {
-			return fmt.Errorf("error while re-fetching block %d: %s", block, err)
+			return 0, fmt.Errorf("error while getting block height from %s ago: %s", window.Lookback, err)
 		}
+		startHeight = height
 	}
 
-	return nil
+	if startHeight < 1 {
+		startHeight = 1
+	}
 
+	return startHeight, nil
 }
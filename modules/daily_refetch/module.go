@@ -2,6 +2,7 @@ package daily_refetch
 
 import (
 	"github.com/forbole/juno/v5/node"
+	"github.com/forbole/juno/v5/types/config"
 
 	bdjunodb "github.com/forbole/bdjuno/v4/database"
 
@@ -14,18 +15,33 @@ var (
 )
 
 type Module struct {
-	node     node.Node
-	database *bdjunodb.Db
+	cfg         *Config
+	startHeight int64
+	node        node.Node
+	database    *bdjunodb.Db
 }
 
 // NewModule builds a new Module instance
 func NewModule(
+	cfg config.Config,
 	node node.Node,
 	database *bdjunodb.Db,
 ) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	refetchCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
-		node:     node,
-		database: database,
+		cfg:         refetchCfg,
+		startHeight: cfg.Parser.StartHeight,
+		node:        node,
+		database:    database,
 	}
 }
 
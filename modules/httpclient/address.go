@@ -0,0 +1,62 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+var (
+	// ErrNonPublicAddress is returned when a client restricted to public addresses tries to reach another one
+	ErrNonPublicAddress = errors.New("address is not public")
+
+	// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which is not covered by net.IP.IsPrivate
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+)
+
+// IsPublicIP tells whether the given IP address is reachable on the public internet, excluding the
+// loopback, private, link-local (including the cloud metadata endpoints), multicast and unspecified ones
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// RestrictToPublicAddresses makes the client refuse to connect to the addresses that are not public.
+// The check is done on the address actually dialed, so that it also covers redirects and host names
+// resolving to internal addresses. Requests refused this way fail with ErrNonPublicAddress and are not retried.
+func (c *Client) RestrictToPublicAddresses() *Client {
+	dialer := &net.Dialer{
+		Timeout:   c.cfg.Timeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil || !IsPublicIP(ip) {
+				return fmt.Errorf("%w: %s", ErrNonPublicAddress, host)
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Requests must not go through a proxy, which would be dialed instead of the target host
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	c.http.Transport = transport
+	return c
+}
@@ -0,0 +1,214 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Client represents an HTTP client used to reach a single upstream.
+// Requests failing because of network errors, rate limits or server errors are retried with
+// an exponential backoff and jitter, honoring the Retry-After header when present.
+type Client struct {
+	upstream string
+	cfg      *Config
+	http     *http.Client
+	sleep    func(time.Duration)
+}
+
+// NewClient returns a new Client for the given upstream using the given configuration,
+// or the default one if nil
+func NewClient(upstream string, cfg *Config) *Client {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	cfg.Validate()
+
+	return &Client{
+		upstream: upstream,
+		cfg:      cfg,
+		http:     &http.Client{Timeout: cfg.Timeout},
+		sleep:    time.Sleep,
+	}
+}
+
+// Get sends a GET request to the given URL with the given headers, and returns the response body.
+// An error is returned if the final response status is not 2xx.
+func (c *Client) Get(url string, headers map[string]string) ([]byte, error) {
+	var lastErr error
+	for attempt := 0; attempt <= c.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			c.sleep(c.backoff(attempt, lastErr))
+		}
+
+		bz, err := c.get(url, headers)
+		if err == nil {
+			return bz, nil
+		}
+
+		lastErr = err
+		if !isRetryable(err) {
+			return nil, err
+		}
+
+		if attempt < c.cfg.MaxRetries {
+			RetryCounter.WithLabelValues(c.upstream, retryReason(err)).Inc()
+		}
+	}
+
+	return nil, lastErr
+}
+
+// GetJSON sends a GET request to the given URL with the given headers,
+// and de-serializes the response body as a JSON object inside the given ptr
+func (c *Client) GetJSON(url string, headers map[string]string, ptr interface{}) error {
+	bz, err := c.Get(url, headers)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(bz, ptr)
+	if err != nil {
+		return fmt.Errorf("error while unmarshaling response body: %s", err)
+	}
+
+	return nil
+}
+
+// get sends a single GET request to the given URL
+func (c *Client) get(url string, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	if c.cfg.APIKey != "" {
+		req.Header.Set(c.cfg.APIKeyHeader, c.cfg.APIKey)
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		observeRequest(c.upstream, start, "error")
+		return nil, &requestError{err: err}
+	}
+
+	defer resp.Body.Close()
+
+	// Read one more byte than allowed to tell whether the body is too large
+	bz, err := io.ReadAll(io.LimitReader(resp.Body, c.cfg.MaxBodySize+1))
+	observeRequest(c.upstream, start, strconv.Itoa(resp.StatusCode))
+	if err != nil {
+		return nil, &requestError{err: fmt.Errorf("error while reading response body: %s", err)}
+	}
+
+	if int64(len(bz)) > c.cfg.MaxBodySize {
+		return nil, fmt.Errorf("response body is larger than %d bytes", c.cfg.MaxBodySize)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &StatusError{
+			StatusCode: resp.StatusCode,
+			Body:       bz,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	return bz, nil
+}
+
+// backoff returns how long to wait before the given retry attempt
+func (c *Client) backoff(attempt int, err error) time.Duration {
+	if statusErr, ok := err.(*StatusError); ok && statusErr.RetryAfter > 0 {
+		if statusErr.RetryAfter > c.cfg.MaxBackoff {
+			return c.cfg.MaxBackoff
+		}
+		return statusErr.RetryAfter
+	}
+
+	backoff := c.cfg.MinBackoff << (attempt - 1)
+	if backoff <= 0 || backoff > c.cfg.MaxBackoff {
+		backoff = c.cfg.MaxBackoff
+	}
+
+	// Wait between half and the whole backoff, so that concurrent clients do not retry together
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// StatusError represents a response with a non-2xx status
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+	RetryAfter time.Duration
+}
+
+// Error implements error
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected response status %d: %s", e.StatusCode, e.Body)
+}
+
+// requestError represents a request that did not get any response
+type requestError struct {
+	err error
+}
+
+// Error implements error
+func (e *requestError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error that made the request fail
+func (e *requestError) Unwrap() error {
+	return e.err
+}
+
+// isRetryable tells whether the request that failed with the given error should be retried
+func isRetryable(err error) bool {
+	switch e := err.(type) {
+	case *requestError:
+		return !errors.Is(e.err, ErrNonPublicAddress)
+	case *StatusError:
+		return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
+// retryReason returns the metrics label describing why the request failed with the given error is retried
+func retryReason(err error) string {
+	if statusErr, ok := err.(*StatusError); ok {
+		return strconv.Itoa(statusErr.StatusCode)
+	}
+	return "error"
+}
+
+// parseRetryAfter parses the given Retry-After header value, expressed either in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
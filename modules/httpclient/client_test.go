@@ -0,0 +1,194 @@
+package httpclient
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a Client recording its waits instead of sleeping
+func newTestClient(cfg *Config) (*Client, *[]time.Duration) {
+	var waits []time.Duration
+	client := NewClient("test", cfg)
+	client.sleep = func(d time.Duration) {
+		waits = append(waits, d)
+	}
+	return client, &waits
+}
+
+func TestClient_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"price":1}`))
+	}))
+	defer server.Close()
+
+	client, waits := newTestClient(&Config{MinBackoff: time.Second, MaxBackoff: 10 * time.Second})
+
+	var res struct {
+		Price int `json:"price"`
+	}
+	err := client.GetJSON(server.URL, nil, &res)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Price)
+	require.Equal(t, int32(3), calls.Load())
+
+	require.Len(t, *waits, 2)
+	require.GreaterOrEqual(t, (*waits)[0], 500*time.Millisecond)
+	require.LessOrEqual(t, (*waits)[0], time.Second)
+	require.GreaterOrEqual(t, (*waits)[1], time.Second)
+	require.LessOrEqual(t, (*waits)[1], 2*time.Second)
+}
+
+func TestClient_HonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`ok`))
+	}))
+	defer server.Close()
+
+	client, waits := newTestClient(nil)
+
+	bz, err := client.Get(server.URL, nil)
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(bz))
+	require.Equal(t, []time.Duration{7 * time.Second}, *waits)
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, _ := newTestClient(nil)
+
+	_, err := client.Get(server.URL, nil)
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, err.(*StatusError).StatusCode)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_GivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, _ := newTestClient(&Config{MaxRetries: 2})
+
+	_, err := client.Get(server.URL, nil)
+	require.Error(t, err)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_SendsAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secret", r.Header.Get("X-Cg-Pro-Api-Key"))
+		require.Equal(t, "application/json", r.Header.Get("Accept"))
+		w.Write([]byte(`ok`))
+	}))
+	defer server.Close()
+
+	client, _ := newTestClient(&Config{APIKey: "secret", APIKeyHeader: "x-cg-pro-api-key"})
+
+	_, err := client.Get(server.URL, map[string]string{"Accept": "application/json"})
+	require.NoError(t, err)
+}
+
+func TestClient_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client, _ := newTestClient(&Config{Timeout: 50 * time.Millisecond, MaxRetries: -1})
+
+	_, err := client.Get(server.URL, nil)
+	require.Error(t, err)
+}
+
+func TestClient_MaxBodySize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`0123456789`))
+	}))
+	defer server.Close()
+
+	client, _ := newTestClient(&Config{MaxBodySize: 10})
+	bz, err := client.Get(server.URL, nil)
+	require.NoError(t, err)
+	require.Equal(t, "0123456789", string(bz))
+
+	client, waits := newTestClient(&Config{MaxBodySize: 9})
+	_, err = client.Get(server.URL, nil)
+	require.Error(t, err)
+	require.Empty(t, *waits)
+}
+
+func TestClient_RestrictToPublicAddresses(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Write([]byte(`ok`))
+	}))
+	defer server.Close()
+
+	client, waits := newTestClient(nil)
+	client.RestrictToPublicAddresses()
+
+	_, err := client.Get(server.URL, nil)
+	require.ErrorIs(t, err, ErrNonPublicAddress)
+	require.Equal(t, int32(0), calls.Load())
+	require.Empty(t, *waits)
+}
+
+func TestIsPublicIP(t *testing.T) {
+	testCases := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "8.8.8.8", expected: true},
+		{ip: "2606:4700:4700::1111", expected: true},
+		{ip: "127.0.0.1", expected: false},
+		{ip: "::1", expected: false},
+		{ip: "10.1.2.3", expected: false},
+		{ip: "172.16.0.1", expected: false},
+		{ip: "192.168.1.1", expected: false},
+		{ip: "169.254.169.254", expected: false},
+		{ip: "100.64.0.1", expected: false},
+		{ip: "fd00:ec2::254", expected: false},
+		{ip: "0.0.0.0", expected: false},
+		{ip: "::ffff:127.0.0.1", expected: false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, IsPublicIP(net.ParseIP(tc.ip)), tc.ip)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	require.Equal(t, 3*time.Second, parseRetryAfter("3"))
+	require.Equal(t, time.Duration(0), parseRetryAfter(""))
+	require.Equal(t, time.Duration(0), parseRetryAfter("invalid"))
+
+	wait := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	require.Greater(t, wait, 50*time.Second)
+}
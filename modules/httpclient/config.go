@@ -0,0 +1,77 @@
+package httpclient
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DefaultTimeout      = 30 * time.Second
+	DefaultMaxRetries   = 3
+	DefaultMinBackoff   = 500 * time.Millisecond
+	DefaultMaxBackoff   = 30 * time.Second
+	DefaultAPIKeyHeader = "X-Api-Key"
+	DefaultMaxBodySize  = 10 << 20
+)
+
+// Config contains the configuration of the client used to reach a single upstream
+type Config struct {
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+
+	// MaxRetries is the number of times a failed request is retried, negative values disable the retries
+	MaxRetries int           `yaml:"max_retries,omitempty"`
+	MinBackoff time.Duration `yaml:"min_backoff,omitempty"`
+	MaxBackoff time.Duration `yaml:"max_backoff,omitempty"`
+
+	// APIKey is sent inside the APIKeyHeader header of every request, when set
+	APIKey       string `yaml:"api_key,omitempty"`
+	APIKeyHeader string `yaml:"api_key_header,omitempty"`
+
+	// MaxBodySize is the maximum number of bytes read from a response body, larger responses are rejected
+	MaxBodySize int64 `yaml:"max_body_size,omitempty"`
+}
+
+// Validate sets the default values of the fields that are not set
+func (cfg *Config) Validate() {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = DefaultMinBackoff
+	}
+
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = DefaultMaxBackoff
+		if cfg.MaxBackoff < cfg.MinBackoff {
+			cfg.MaxBackoff = cfg.MinBackoff
+		}
+	}
+
+	if cfg.APIKeyHeader == "" {
+		cfg.APIKeyHeader = DefaultAPIKeyHeader
+	}
+
+	if cfg.MaxBodySize <= 0 {
+		cfg.MaxBodySize = DefaultMaxBodySize
+	}
+}
+
+// ParseConfig reads the configuration of each upstream, identified by its name
+func ParseConfig(bz []byte) (map[string]*Config, error) {
+	type T struct {
+		Config map[string]*Config `yaml:"http_clients"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	return cfg.Config, err
+}
@@ -0,0 +1,51 @@
+package httpclient
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RequestCounter represents the Telemetry counter used to track the requests sent to each upstream
+var RequestCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "bdjuno_http_client_requests_total",
+		Help: "Total number of requests sent to each upstream.",
+	}, []string{"upstream", "status"},
+)
+
+// ResponseTime represents the Telemetry histogram used to track the response time of each upstream
+var ResponseTime = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "bdjuno_http_client_response_time",
+		Help:    "Time it has taken each upstream to reply.",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 30},
+	}, []string{"upstream"},
+)
+
+// RetryCounter represents the Telemetry counter used to track the requests retried for each upstream
+var RetryCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "bdjuno_http_client_retries_total",
+		Help: "Total number of requests retried for each upstream.",
+	}, []string{"upstream", "reason"},
+)
+
+func init() {
+	for _, collector := range []prometheus.Collector{
+		RequestCounter,
+		ResponseTime,
+		RetryCounter,
+	} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// observeRequest records the outcome of a request sent to the given upstream
+func observeRequest(upstream string, start time.Time, status string) {
+	RequestCounter.WithLabelValues(upstream, status).Inc()
+	ResponseTime.WithLabelValues(upstream).Observe(time.Since(start).Seconds())
+}
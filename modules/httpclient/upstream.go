@@ -0,0 +1,61 @@
+package httpclient
+
+import (
+	"sync"
+
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	upstreamsMu sync.Mutex
+	upstreams   = map[string]*Client{}
+	configs     map[string]*Config
+)
+
+// Upstream returns the client shared by all the integrations reaching the given upstream,
+// configured by the http_clients section of the global configuration
+func Upstream(name string) *Client {
+	upstreamsMu.Lock()
+	defer upstreamsMu.Unlock()
+
+	if client, ok := upstreams[name]; ok {
+		return client
+	}
+
+	if configs == nil {
+		configs = loadConfigs()
+	}
+
+	var cfg *Config
+	if upstreamCfg, ok := configs[name]; ok && upstreamCfg != nil {
+		// Copy the configuration so that the global one is not modified
+		copied := *upstreamCfg
+		cfg = &copied
+	}
+
+	client := NewClient(name, cfg)
+	upstreams[name] = client
+	return client
+}
+
+// loadConfigs reads the upstreams configuration from the global configuration
+func loadConfigs() map[string]*Config {
+	bz, err := config.Cfg.GetBytes()
+	if err != nil {
+		log.Error().Str("module", "http_client").Err(err).Msg("error while reading config, using defaults")
+		return map[string]*Config{}
+	}
+
+	cfgs, err := ParseConfig(bz)
+	if err != nil {
+		log.Error().Str("module", "http_client").Err(err).Msg("error while parsing config, using defaults")
+		return map[string]*Config{}
+	}
+
+	if cfgs == nil {
+		cfgs = map[string]*Config{}
+	}
+
+	return cfgs
+}
@@ -0,0 +1,91 @@
+package nodepool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/juno/v5/node/remote"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	StrategyPriority   = "priority"
+	StrategyRoundRobin = "round_robin"
+)
+
+const (
+	DefaultHealthCheckInterval = 10 * time.Second
+	DefaultHealthCheckTimeout  = 5 * time.Second
+)
+
+// Config contains the configuration about a pool of remote nodes
+type Config struct {
+	// Strategy tells how the endpoint serving each request is selected among the healthy ones.
+	// With "priority" the first healthy endpoint is always used, while with "round_robin"
+	// the requests are spread across all of them.
+	Strategy            string            `yaml:"strategy,omitempty"`
+	HealthCheckInterval time.Duration     `yaml:"health_check_interval,omitempty"`
+	HealthCheckTimeout  time.Duration     `yaml:"health_check_timeout,omitempty"`
+	Endpoints           []*remote.Details `yaml:"endpoints"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(strategy string, healthCheckInterval time.Duration, endpoints []*remote.Details) *Config {
+	return &Config{
+		Strategy:            strategy,
+		HealthCheckInterval: healthCheckInterval,
+		HealthCheckTimeout:  DefaultHealthCheckTimeout,
+		Endpoints:           endpoints,
+	}
+}
+
+// Validate checks that the configuration is valid, setting the default values where needed
+func (c *Config) Validate() error {
+	if len(c.Endpoints) == 0 {
+		return fmt.Errorf("node pool must contain at least one endpoint")
+	}
+
+	for i, endpoint := range c.Endpoints {
+		err := endpoint.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid node pool endpoint %d: %s", i, err)
+		}
+	}
+
+	switch c.Strategy {
+	case "":
+		c.Strategy = StrategyPriority
+	case StrategyPriority, StrategyRoundRobin:
+	default:
+		return fmt.Errorf("invalid node pool strategy: %s", c.Strategy)
+	}
+
+	if c.HealthCheckInterval == 0 {
+		c.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+
+	if c.HealthCheckTimeout == 0 {
+		c.HealthCheckTimeout = DefaultHealthCheckTimeout
+	}
+
+	return nil
+}
+
+// ParseConfig reads the node pool configuration from the given bytes.
+// It returns nil if no node pool has been configured.
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"node_pool"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return nil, nil
+	}
+
+	return cfg.Config, cfg.Config.Validate()
+}
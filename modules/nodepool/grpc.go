@@ -0,0 +1,30 @@
+package nodepool
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+var (
+	_ grpc.ClientConnInterface = &Pool{}
+)
+
+// Invoke implements grpc.ClientConnInterface, sending the unary call to the first reachable endpoint
+func (p *Pool) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	return p.do(kindGRPC, func(e *endpoint) error {
+		return e.conn.Invoke(ctx, method, args, reply, opts...)
+	})
+}
+
+// NewStream implements grpc.ClientConnInterface.
+// Streams cannot be moved to another endpoint once started, so only their creation fails over.
+func (p *Pool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	var stream grpc.ClientStream
+	err := p.do(kindGRPC, func(e *endpoint) error {
+		var err error
+		stream, err = e.conn.NewStream(ctx, desc, method, opts...)
+		return err
+	})
+	return stream, err
+}
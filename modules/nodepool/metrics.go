@@ -0,0 +1,74 @@
+package nodepool
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EndpointRequestCounter represents the Telemetry counter used to track the requests sent to each endpoint
+var EndpointRequestCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "bdjuno_node_pool_requests_total",
+		Help: "Total number of requests sent to each node pool endpoint.",
+	}, []string{"endpoint", "kind", "status"},
+)
+
+// EndpointResponseTime represents the Telemetry histogram used to track the response time of each endpoint
+var EndpointResponseTime = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "bdjuno_node_pool_response_time",
+		Help:    "Time it has taken each node pool endpoint to reply.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+	}, []string{"endpoint", "kind"},
+)
+
+// EndpointHealthGauge represents the Telemetry gauge used to track whether each endpoint is healthy
+var EndpointHealthGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "bdjuno_node_pool_endpoint_healthy",
+		Help: "Whether the node pool endpoint is healthy (1) or not (0).",
+	}, []string{"endpoint"},
+)
+
+// EndpointFailoverCounter represents the Telemetry counter used to track how many times a request failed over
+var EndpointFailoverCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "bdjuno_node_pool_failovers_total",
+		Help: "Total number of requests moved away from a failing node pool endpoint.",
+	}, []string{"endpoint", "kind"},
+)
+
+func init() {
+	for _, collector := range []prometheus.Collector{
+		EndpointRequestCounter,
+		EndpointResponseTime,
+		EndpointHealthGauge,
+		EndpointFailoverCounter,
+	} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// observeRequest records the outcome of a request sent to the given endpoint
+func observeRequest(endpoint, kind string, start time.Time, err error) {
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+
+	EndpointRequestCounter.WithLabelValues(endpoint, kind, status).Inc()
+	EndpointResponseTime.WithLabelValues(endpoint, kind).Observe(time.Since(start).Seconds())
+}
+
+// setHealth records whether the given endpoint is healthy
+func setHealth(endpoint string, healthy bool) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	EndpointHealthGauge.WithLabelValues(endpoint).Set(value)
+}
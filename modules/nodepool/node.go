@@ -0,0 +1,127 @@
+package nodepool
+
+import (
+	"context"
+
+	constypes "github.com/cometbft/cometbft/consensus/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/forbole/juno/v5/node"
+	"github.com/forbole/juno/v5/types"
+)
+
+var (
+	_ node.Node = &Pool{}
+)
+
+// Genesis implements node.Node
+func (p *Pool) Genesis() (res *tmctypes.ResultGenesis, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.Genesis()
+		return err
+	})
+	return res, err
+}
+
+// ConsensusState implements node.Node
+func (p *Pool) ConsensusState() (res *constypes.RoundStateSimple, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.ConsensusState()
+		return err
+	})
+	return res, err
+}
+
+// LatestHeight implements node.Node.
+// The lowest height among the healthy endpoints is returned, so that the requests following it
+// can be served by any of them even if they are moved to a lagging one.
+func (p *Pool) LatestHeight() (int64, error) {
+	return p.minHeight()
+}
+
+// ChainID implements node.Node
+func (p *Pool) ChainID() (res string, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.ChainID()
+		return err
+	})
+	return res, err
+}
+
+// Validators implements node.Node
+func (p *Pool) Validators(height int64) (res *tmctypes.ResultValidators, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.Validators(height)
+		return err
+	})
+	return res, err
+}
+
+// Block implements node.Node
+func (p *Pool) Block(height int64) (res *tmctypes.ResultBlock, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.Block(height)
+		return err
+	})
+	return res, err
+}
+
+// BlockResults implements node.Node
+func (p *Pool) BlockResults(height int64) (res *tmctypes.ResultBlockResults, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.BlockResults(height)
+		return err
+	})
+	return res, err
+}
+
+// Tx implements node.Node
+func (p *Pool) Tx(hash string) (res *types.Tx, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.Tx(hash)
+		return err
+	})
+	return res, err
+}
+
+// Txs implements node.Node
+func (p *Pool) Txs(block *tmctypes.ResultBlock) (res []*types.Tx, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.Txs(block)
+		return err
+	})
+	return res, err
+}
+
+// TxSearch implements node.Node
+func (p *Pool) TxSearch(query string, page *int, perPage *int, orderBy string) (res *tmctypes.ResultTxSearch, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.TxSearch(query, page, perPage, orderBy)
+		return err
+	})
+	return res, err
+}
+
+// SubscribeEvents implements node.Node.
+// Subscriptions cannot be moved to another endpoint once started, so only their creation fails over.
+func (p *Pool) SubscribeEvents(subscriber, query string) (ch <-chan tmctypes.ResultEvent, cancel context.CancelFunc, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		ch, cancel, err = e.node.SubscribeEvents(subscriber, query)
+		return err
+	})
+	return ch, cancel, err
+}
+
+// SubscribeNewBlocks implements node.Node.
+// Subscriptions cannot be moved to another endpoint once started, so only their creation fails over.
+func (p *Pool) SubscribeNewBlocks(subscriber string) (ch <-chan tmctypes.ResultEvent, cancel context.CancelFunc, err error) {
+	err = p.do(kindRPC, func(e *endpoint) error {
+		ch, cancel, err = e.node.SubscribeNewBlocks(subscriber)
+		return err
+	})
+	return ch, cancel, err
+}
+
+// Stop implements node.Node
+func (p *Pool) Stop() {
+	p.Close()
+}
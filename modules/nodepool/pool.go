@@ -0,0 +1,284 @@
+package nodepool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/forbole/juno/v5/node"
+	"github.com/forbole/juno/v5/node/remote"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	kindRPC  = "rpc"
+	kindGRPC = "grpc"
+)
+
+// endpoint represents a single remote node inside the pool
+type endpoint struct {
+	name    string
+	node    node.Node
+	conn    *grpc.ClientConn
+	healthy atomic.Bool
+}
+
+// Pool represents a set of remote nodes serving the same chain.
+// Each request is sent to one of the healthy nodes based on the configured strategy,
+// and moved to the next one when the node cannot be reached.
+type Pool struct {
+	cfg       *Config
+	endpoints []*endpoint
+	next      atomic.Uint64
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// NewPool builds a new Pool connecting to all the endpoints of the given configuration,
+// and starts checking their health periodically
+func NewPool(cfg *Config, cdc codec.Codec) (*Pool, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &Pool{
+		cfg:  cfg,
+		stop: make(chan struct{}),
+	}
+
+	for _, details := range cfg.Endpoints {
+		junoNode, err := remote.NewNode(details, cdc)
+		if err != nil {
+			return nil, fmt.Errorf("error while connecting to node %s: %s", details.RPC.Address, err)
+		}
+
+		conn, err := remote.CreateGrpcConnection(details.GRPC)
+		if err != nil {
+			return nil, fmt.Errorf("error while connecting to node %s: %s", details.GRPC.Address, err)
+		}
+
+		e := &endpoint{
+			name: details.RPC.Address,
+			node: junoNode,
+			conn: conn,
+		}
+		e.healthy.Store(true)
+		setHealth(e.name, true)
+
+		pool.endpoints = append(pool.endpoints, e)
+	}
+
+	go pool.checkHealth()
+
+	return pool, nil
+}
+
+// candidates returns the endpoints that should be tried, in order, to serve the next request.
+// Healthy endpoints always come first, while unhealthy ones are kept as a last resort.
+func (p *Pool) candidates() []*endpoint {
+	var healthy, unhealthy []*endpoint
+	for _, e := range p.endpoints {
+		if e.healthy.Load() {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	if p.cfg.Strategy == StrategyRoundRobin && len(healthy) > 1 {
+		start := int(p.next.Add(1) % uint64(len(healthy)))
+		rotated := make([]*endpoint, 0, len(p.endpoints))
+		rotated = append(rotated, healthy[start:]...)
+		healthy = append(rotated, healthy[:start]...)
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// do executes the given request on the candidate endpoints, until one of them is reachable
+func (p *Pool) do(kind string, request func(e *endpoint) error) error {
+	var err error
+	for _, e := range p.candidates() {
+		start := time.Now()
+		err = request(e)
+		observeRequest(e.name, kind, start, err)
+
+		if err == nil || !isUnavailable(err) {
+			return err
+		}
+
+		p.markUnhealthy(e, err)
+		EndpointFailoverCounter.WithLabelValues(e.name, kind).Inc()
+	}
+
+	return err
+}
+
+// minHeight returns the lowest latest height among the healthy endpoints, querying all of them at once.
+// Unreachable endpoints are marked as unhealthy and ignored, and the unhealthy endpoints are only
+// tried when none of the healthy ones replied.
+func (p *Pool) minHeight() (int64, error) {
+	var healthy []*endpoint
+	for _, e := range p.endpoints {
+		if e.healthy.Load() {
+			healthy = append(healthy, e)
+		}
+	}
+
+	heights := make([]int64, len(healthy))
+	errs := make([]error, len(healthy))
+
+	var wg sync.WaitGroup
+	for i, e := range healthy {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+
+			start := time.Now()
+			heights[i], errs[i] = e.node.LatestHeight()
+			observeRequest(e.name, kindRPC, start, errs[i])
+		}(i, e)
+	}
+	wg.Wait()
+
+	var res int64
+	var replied bool
+	for i, e := range healthy {
+		switch {
+		case errs[i] == nil:
+			if !replied || heights[i] < res {
+				res = heights[i]
+			}
+			replied = true
+
+		case isUnavailable(errs[i]):
+			p.markUnhealthy(e, errs[i])
+			EndpointFailoverCounter.WithLabelValues(e.name, kindRPC).Inc()
+
+		default:
+			return 0, errs[i]
+		}
+	}
+
+	if replied {
+		return res, nil
+	}
+
+	var err error
+	err = p.do(kindRPC, func(e *endpoint) error {
+		res, err = e.node.LatestHeight()
+		return err
+	})
+	return res, err
+}
+
+// markUnhealthy marks the given endpoint as unhealthy until the next successful health check
+func (p *Pool) markUnhealthy(e *endpoint, err error) {
+	if e.healthy.Swap(false) {
+		log.Warn().Str("module", "node_pool").Str("endpoint", e.name).Err(err).
+			Msg("node endpoint is unavailable")
+	}
+	setHealth(e.name, false)
+}
+
+// checkHealth periodically checks whether each endpoint is reachable
+func (p *Pool) checkHealth() {
+	ticker := time.NewTicker(p.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			for _, e := range p.endpoints {
+				p.checkEndpoint(e)
+			}
+		}
+	}
+}
+
+// checkEndpoint checks whether the given endpoint replies within the configured timeout
+func (p *Pool) checkEndpoint(e *endpoint) {
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := e.node.LatestHeight()
+		errCh <- err
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-time.After(p.cfg.HealthCheckTimeout):
+		err = fmt.Errorf("health check timed out after %s", p.cfg.HealthCheckTimeout)
+	}
+
+	if err != nil {
+		p.markUnhealthy(e, err)
+		return
+	}
+
+	if !e.healthy.Swap(true) {
+		log.Info().Str("module", "node_pool").Str("endpoint", e.name).Msg("node endpoint is available again")
+	}
+	setHealth(e.name, true)
+}
+
+// Close stops the health checks and closes the connections to all the endpoints
+func (p *Pool) Close() {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+		for _, e := range p.endpoints {
+			e.node.Stop()
+			e.conn.Close()
+		}
+	})
+}
+
+// isUnavailable tells whether the given error means that the endpoint could not be reached,
+// as opposed to an error returned by a reachable node
+func isUnavailable(err error) bool {
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+			return true
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	// The RPC client often wraps the underlying errors into plain strings, so only the messages
+	// of the network and HTTP errors are matched to avoid failing over on errors returned by the node
+	message := strings.ToLower(err.Error())
+	for _, text := range []string{
+		"connection refused", "connection reset by peer", "broken pipe", "no such host", "i/o timeout",
+		"unexpected eof", "502 bad gateway", "503 service unavailable", "504 gateway timeout",
+	} {
+		if strings.Contains(message, text) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+package nodepool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/forbole/juno/v5/node"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockNode struct {
+	node.Node
+	height int64
+	err    error
+	calls  int
+}
+
+func (n *mockNode) LatestHeight() (int64, error) {
+	n.calls++
+	return n.height, n.err
+}
+
+func (n *mockNode) ChainID() (string, error) {
+	n.calls++
+	return "chain", n.err
+}
+
+func newTestPool(strategy string, nodes ...*mockNode) *Pool {
+	pool := &Pool{cfg: &Config{Strategy: strategy}, stop: make(chan struct{})}
+	for i, n := range nodes {
+		e := &endpoint{name: fmt.Sprintf("node-%d", i), node: n}
+		e.healthy.Store(true)
+		pool.endpoints = append(pool.endpoints, e)
+	}
+	return pool
+}
+
+func TestPool_Failover(t *testing.T) {
+	first := &mockNode{err: fmt.Errorf("dial tcp: connection refused")}
+	second := &mockNode{}
+	pool := newTestPool(StrategyPriority, first, second)
+
+	_, err := pool.ChainID()
+	require.NoError(t, err)
+	require.False(t, pool.endpoints[0].healthy.Load())
+
+	// The unhealthy endpoint is not tried again until it recovers
+	_, err = pool.ChainID()
+	require.NoError(t, err)
+	require.Equal(t, 1, first.calls)
+	require.Equal(t, 2, second.calls)
+}
+
+func TestPool_NodeErrorDoesNotFailover(t *testing.T) {
+	first := &mockNode{err: fmt.Errorf("height 100 is not available")}
+	second := &mockNode{}
+	pool := newTestPool(StrategyPriority, first, second)
+
+	_, err := pool.ChainID()
+	require.Error(t, err)
+	require.True(t, pool.endpoints[0].healthy.Load())
+	require.Equal(t, 0, second.calls)
+}
+
+func TestPool_RoundRobin(t *testing.T) {
+	first := &mockNode{}
+	second := &mockNode{}
+	pool := newTestPool(StrategyRoundRobin, first, second)
+
+	for i := 0; i < 4; i++ {
+		_, err := pool.ChainID()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 2, first.calls)
+	require.Equal(t, 2, second.calls)
+}
+
+func TestPool_LatestHeight(t *testing.T) {
+	lagging := &mockNode{height: 8}
+	synced := &mockNode{height: 10}
+	unavailable := &mockNode{err: fmt.Errorf("dial tcp: i/o timeout")}
+	pool := newTestPool(StrategyRoundRobin, synced, unavailable, lagging)
+
+	// The lowest height is returned whichever endpoint is tried first
+	for i := 0; i < 3; i++ {
+		height, err := pool.LatestHeight()
+		require.NoError(t, err)
+		require.Equal(t, int64(8), height)
+	}
+
+	require.False(t, pool.endpoints[1].healthy.Load())
+	require.Equal(t, 1, unavailable.calls)
+}
+
+func TestPool_LatestHeight_NoHealthyEndpoint(t *testing.T) {
+	first := &mockNode{height: 10}
+	pool := newTestPool(StrategyPriority, first)
+	pool.endpoints[0].healthy.Store(false)
+
+	// Unhealthy endpoints are kept as a last resort
+	height, err := pool.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+}
+
+func TestPool_LatestHeight_NodeError(t *testing.T) {
+	first := &mockNode{height: 10}
+	second := &mockNode{err: fmt.Errorf("internal error")}
+	pool := newTestPool(StrategyPriority, first, second)
+
+	_, err := pool.LatestHeight()
+	require.Error(t, err)
+	require.True(t, pool.endpoints[1].healthy.Load())
+}
+
+func TestIsUnavailable(t *testing.T) {
+	require.True(t, isUnavailable(status.Error(codes.Unavailable, "connection closed")))
+	require.False(t, isUnavailable(status.Error(codes.NotFound, "not found")))
+	require.False(t, isUnavailable(fmt.Errorf("invalid height")))
+
+	require.True(t, isUnavailable(fmt.Errorf("post failed: dial tcp 10.0.0.1:26657: i/o timeout")))
+	require.True(t, isUnavailable(fmt.Errorf("read tcp: connection reset by peer")))
+	require.True(t, isUnavailable(fmt.Errorf("error in json rpc client, with http response metadata: (Status: 502 Bad Gateway, Protocol HTTP/1.1)")))
+
+	// Errors returned by a reachable node must not be mistaken for network failures
+	require.False(t, isUnavailable(fmt.Errorf("failed to unmarshal response: unexpected end of JSON input: EOF")))
+	require.False(t, isUnavailable(fmt.Errorf("tx search timeout parameter is invalid")))
+}
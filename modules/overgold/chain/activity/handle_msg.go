@@ -0,0 +1,33 @@
+package activity
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleMsg implements MessageModule
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
+	module, involved := Parse(msg)
+	if len(involved) == 0 {
+		return nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	activities := make([]types.AddressActivity, 0, len(involved))
+	for _, i := range involved {
+		activities = append(activities, types.NewAddressActivity(
+			tx.TxHash, index, tx.Height, i.Address, i.Role, module, msgType, timestamp))
+	}
+
+	return m.activityRepo.InsertAddressActivities(activities...)
+}
@@ -0,0 +1,36 @@
+package activity
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/forbole/juno/v5/modules"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/activity"
+)
+
+var (
+	_ modules.Module        = &Module{}
+	_ modules.GenesisModule = &Module{}
+	_ modules.MessageModule = &Module{}
+)
+
+// Module represents the module that indexes the activity of the addresses involved in the OverGold messages
+type Module struct {
+	cdc          codec.Codec
+	db           *database.Db
+	activityRepo activity.Repository
+}
+
+// NewModule returns a new Module instance
+func NewModule(cdc codec.Codec, db *database.Db) *Module {
+	return &Module{
+		cdc:          cdc,
+		db:           db,
+		activityRepo: *activity.NewRepository(db.Sqlx),
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "overgold_activity"
+}
@@ -0,0 +1,177 @@
+package activity
+
+import (
+	allowed "git.ooo.ua/vipcoin/ovg-chain/x/allowed/types"
+	core "git.ooo.ua/vipcoin/ovg-chain/x/core/types"
+	feeexcluder "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	referral "git.ooo.ua/vipcoin/ovg-chain/x/referral/types"
+	stake "git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	ModuleAllowed     = "allowed"
+	ModuleBank        = "bank"
+	ModuleCore        = "core"
+	ModuleFeeExcluder = "feeexcluder"
+	ModuleReferral    = "referral"
+	ModuleStake       = "stake"
+)
+
+// Involvement represents the role played by an address inside a message
+type Involvement struct {
+	Address string
+	Role    string
+}
+
+// involvements collects the addresses involved in a message, skipping the empty and duplicated ones
+type involvements []Involvement
+
+func (i *involvements) add(role string, addresses ...string) {
+	for _, address := range addresses {
+		if address == "" || i.contains(address, role) {
+			continue
+		}
+
+		*i = append(*i, Involvement{Address: address, Role: role})
+	}
+}
+
+func (i involvements) contains(address, role string) bool {
+	for _, involvement := range i {
+		if involvement.Address == address && involvement.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parse returns the name of the module handling the given message along with the addresses involved in it.
+// Messages that are not handled by the OverGold modules return no addresses.
+func Parse(msg sdk.Msg) (string, []Involvement) {
+	var res involvements
+
+	switch msg := msg.(type) {
+	// allowed
+	case *allowed.MsgCreateAddresses:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSubject, msg.Address...)
+		return ModuleAllowed, res
+	case *allowed.MsgUpdateAddresses:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSubject, msg.Address...)
+		return ModuleAllowed, res
+	case *allowed.MsgDeleteByAddresses:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSubject, msg.Address...)
+		return ModuleAllowed, res
+	case *allowed.MsgDeleteByID:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleAllowed, res
+
+	// bank
+	case *bank.MsgSend:
+		res.add(types.ActivityRoleSender, msg.FromAddress)
+		res.add(types.ActivityRoleReceiver, msg.ToAddress)
+		return ModuleBank, res
+	case *bank.MsgMultiSend:
+		for _, input := range msg.Inputs {
+			res.add(types.ActivityRoleSender, input.Address)
+		}
+		for _, output := range msg.Outputs {
+			res.add(types.ActivityRoleReceiver, output.Address)
+		}
+		return ModuleBank, res
+
+	// core
+	case *core.MsgIssue:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleReceiver, msg.Address)
+		return ModuleCore, res
+	case *core.MsgWithdraw:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSender, msg.Address)
+		return ModuleCore, res
+	case *core.MsgSend:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSender, msg.From)
+		res.add(types.ActivityRoleReceiver, msg.To)
+		return ModuleCore, res
+
+	// feeexcluder
+	case *feeexcluder.MsgCreateAddress:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSubject, msg.Address)
+		return ModuleFeeExcluder, res
+	case *feeexcluder.MsgUpdateAddress:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSubject, msg.Address)
+		return ModuleFeeExcluder, res
+	case *feeexcluder.MsgDeleteAddress:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleFeeExcluder, res
+	case *feeexcluder.MsgCreateTariffs:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleFeeExcluder, res
+	case *feeexcluder.MsgUpdateTariffs:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleFeeExcluder, res
+	case *feeexcluder.MsgDeleteTariffs:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleFeeExcluder, res
+
+	// referral
+	case *referral.MsgSetReferrer:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleReferrer, msg.ReferrerAddress)
+		res.add(types.ActivityRoleReferral, msg.ReferralAddress)
+		return ModuleReferral, res
+
+	// stake
+	case *stake.MsgSellRequest:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleStake, res
+	case *stake.MsgBuyRequest:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleStake, res
+	case *stake.MsgMsgCancelSell:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleStake, res
+	case *stake.MsgClaimReward:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleStake, res
+	case *stake.MsgDistributeRewards:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleStake, res
+	case *stake.MsgTransferFromUser:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSender, msg.Address)
+		res.add(types.ActivityRoleReceiver, msg.Creator)
+		return ModuleStake, res
+	case *stake.MsgTransferToUser:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSender, msg.Creator)
+		res.add(types.ActivityRoleReceiver, msg.Address)
+		return ModuleStake, res
+	case *stake.MsgCreateSystemStakeAccountAddress:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSubject, msg.Address)
+		return ModuleStake, res
+	case *stake.MsgUpdateSystemStakeAccountAddress:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		res.add(types.ActivityRoleSubject, msg.Address)
+		return ModuleStake, res
+	case *stake.MsgDeleteSystemStakeAccountAddress:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleStake, res
+	case *stake.MsgManageSystemStake:
+		res.add(types.ActivityRoleCreator, msg.Creator)
+		return ModuleStake, res
+	}
+
+	return "", nil
+}
@@ -1,7 +1,11 @@
 package local
 
 import (
+	"fmt"
+
 	allowedtypes "git.ooo.ua/vipcoin/ovg-chain/x/allowed/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/forbole/juno/v5/node/local"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/allowed/source"
@@ -27,5 +31,34 @@ func NewSource(source *local.Source, allowedServer allowedtypes.QueryServer) *So
 
 // GetAddresses implements Source
 func (s Source) GetAddresses(addresses []string, height int64) ([]*allowedtypes.Addresses, error) {
-	return nil, nil
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	var all []*allowedtypes.Addresses
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.allowedServer.AddressesAll(
+			sdk.WrapSDKContext(ctx),
+			&allowedtypes.QueryAllAddressesRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 addresses at time
+				},
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Addresses {
+			all = append(all, &res.Addresses[i])
+		}
+
+		nextKey = res.Pagination.GetNextKey()
+		stop = len(nextKey) == 0
+	}
+
+	return source.FilterAddresses(all, addresses), nil
 }
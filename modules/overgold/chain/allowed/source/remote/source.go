@@ -1,6 +1,9 @@
 package remote
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/forbole/juno/v5/node/remote"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/allowed/source"
@@ -28,5 +31,31 @@ func NewSource(source *remote.Source, allowedClient allowedtypes.QueryClient) *S
 
 // GetAddresses implements Source
 func (s Source) GetAddresses(addresses []string, height int64) ([]*allowedtypes.Addresses, error) {
-	return []*allowedtypes.Addresses{}, nil
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	var all []*allowedtypes.Addresses
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.client.AddressesAll(
+			ctx,
+			&allowedtypes.QueryAllAddressesRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 addresses at time
+				},
+			})
+		if err != nil {
+			return nil, fmt.Errorf("error while getting allowed addresses: %s", err)
+		}
+
+		for i := range res.Addresses {
+			all = append(all, &res.Addresses[i])
+		}
+
+		nextKey = res.Pagination.GetNextKey()
+		stop = len(nextKey) == 0
+	}
+
+	return source.FilterAddresses(all, addresses), nil
 }
@@ -5,5 +5,31 @@ import (
 )
 
 type Source interface {
+	// GetAddresses returns the entries containing the given addresses, or all of them when no address is given
 	GetAddresses(addresses []string, height int64) ([]*allowedtypes.Addresses, error)
 }
+
+// FilterAddresses returns the entries containing at least one of the given addresses,
+// or all of them when no address is given
+func FilterAddresses(entries []*allowedtypes.Addresses, addresses []string) []*allowedtypes.Addresses {
+	if len(addresses) == 0 {
+		return entries
+	}
+
+	wanted := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		wanted[address] = true
+	}
+
+	var filtered []*allowedtypes.Addresses
+	for _, entry := range entries {
+		for _, address := range entry.Address {
+			if wanted[address] {
+				filtered = append(filtered, entry)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,53 @@
+package allowed
+
+import (
+	"errors"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	allowed "git.ooo.ua/vipcoin/ovg-chain/x/allowed/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// RefreshState compares the allowed addresses stored inside the database with the ones of the node
+// at the given height, returning the differences. When overwrite is true, the stored addresses are
+// replaced with the node ones.
+func (m *Module) RefreshState(height int64, overwrite bool) ([]types.OverGoldStateDiff, error) {
+	indexedAddresses, err := m.allowedRepo.GetAllAddresses(filter.NewFilter())
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, err
+	}
+
+	nodeAddresses, err := m.keeper.GetAddresses(nil, height)
+	if err != nil {
+		return nil, err
+	}
+
+	indexed := make(map[string]string)
+	for _, a := range indexedAddresses {
+		for _, address := range a.Address {
+			indexed[address] = a.Creator
+		}
+	}
+
+	addresses := make([]allowed.Addresses, 0, len(nodeAddresses))
+	node := make(map[string]string)
+	for _, a := range nodeAddresses {
+		addresses = append(addresses, *a)
+		for _, address := range a.Address {
+			node[address] = a.Creator
+		}
+	}
+
+	diffs := types.DiffOverGoldState(m.Name(), indexed, node)
+	if !overwrite || len(diffs) == 0 {
+		return diffs, nil
+	}
+
+	if err = types.CheckOverGoldStateOverwrite(diffs, len(node)); err != nil {
+		return nil, err
+	}
+
+	return diffs, m.allowedRepo.ReplaceAddresses(addresses...)
+}
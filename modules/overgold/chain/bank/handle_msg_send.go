@@ -3,13 +3,30 @@ package bank
 import (
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/events"
 )
 
 // handleMsgSend allows to properly handle a MsgSend
-func (m *Module) handleMsgSend(tx *juno.Tx, _ int, msg *bank.MsgSend) error {
-	return m.bankRepo.InsertMsgSend(tx.TxHash, bank.MsgSend{
+func (m *Module) handleMsgSend(tx *juno.Tx, index int, msg *bank.MsgSend) error {
+	if err := m.bankRepo.InsertMsgSend(tx.TxHash, bank.MsgSend{
 		FromAddress: msg.FromAddress,
 		ToAddress:   msg.ToAddress,
 		Amount:      msg.Amount,
-	})
+	}); err != nil {
+		return err
+	}
+
+	// The fee charged to the sender is only known from the emitted events
+	msgEvents, err := events.Parse(tx, index)
+	if err != nil {
+		return err
+	}
+
+	fee := msgEvents.TotalFeesCharged(msg.FromAddress)
+	if fee.IsZero() {
+		return nil
+	}
+
+	return m.bankRepo.UpdateMsgSendFee(tx.TxHash, fee)
 }
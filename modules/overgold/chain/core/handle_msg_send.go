@@ -3,15 +3,32 @@ package core
 import (
 	"git.ooo.ua/vipcoin/ovg-chain/x/core/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/events"
 )
 
 // handleMsgSend allows to properly handle a MsgSend
-func (m *Module) handleMsgSend(tx *juno.Tx, _ int, msg *types.MsgSend) error {
-	return m.coreRepo.InsertMsgSend(tx.TxHash, types.MsgSend{
+func (m *Module) handleMsgSend(tx *juno.Tx, index int, msg *types.MsgSend) error {
+	if err := m.coreRepo.InsertMsgSend(tx.TxHash, types.MsgSend{
 		Creator: msg.Creator,
 		From:    msg.From,
 		To:      msg.To,
 		Amount:  msg.Amount,
 		Denom:   msg.Denom,
-	})
+	}); err != nil {
+		return err
+	}
+
+	// The fee charged to the sender is only known from the emitted events
+	msgEvents, err := events.Parse(tx, index)
+	if err != nil {
+		return err
+	}
+
+	fee := msgEvents.TotalFeesCharged(msg.From)
+	if fee.IsZero() {
+		return nil
+	}
+
+	return m.coreRepo.UpdateMsgSendFee(tx.TxHash, fee)
 }
@@ -0,0 +1,32 @@
+package events
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+)
+
+// HandleMsg implements MessageModule
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
+	if len(tx.Logs) == 0 || !IsOverGoldMsg(msg) {
+		return nil
+	}
+
+	msgEvents, err := Parse(tx, index)
+	if err != nil {
+		return err
+	}
+
+	if msgEvents.IsEmpty() {
+		return nil
+	}
+
+	if err = m.eventsRepo.InsertTransferEvents(msgEvents.Transfers...); err != nil {
+		return err
+	}
+
+	if err = m.eventsRepo.InsertFeeChargedEvents(msgEvents.FeesCharged...); err != nil {
+		return err
+	}
+
+	return m.eventsRepo.InsertRewardPaidEvents(msgEvents.RewardsPaid...)
+}
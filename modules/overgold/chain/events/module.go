@@ -0,0 +1,36 @@
+package events
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/forbole/juno/v5/modules"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/database/overgold/chain/events"
+)
+
+var (
+	_ modules.Module        = &Module{}
+	_ modules.GenesisModule = &Module{}
+	_ modules.MessageModule = &Module{}
+)
+
+// Module represents the module that indexes the typed events emitted by the OverGold modules
+type Module struct {
+	cdc        codec.Codec
+	db         *database.Db
+	eventsRepo events.Repository
+}
+
+// NewModule returns a new Module instance
+func NewModule(cdc codec.Codec, db *database.Db) *Module {
+	return &Module{
+		cdc:        cdc,
+		db:         db,
+		eventsRepo: *events.NewRepository(db.Sqlx),
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "overgold_events"
+}
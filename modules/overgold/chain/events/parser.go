@@ -0,0 +1,144 @@
+package events
+
+import (
+	"fmt"
+
+	allowed "git.ooo.ua/vipcoin/ovg-chain/x/allowed/types"
+	core "git.ooo.ua/vipcoin/ovg-chain/x/core/types"
+	feeexcluder "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	referral "git.ooo.ua/vipcoin/ovg-chain/x/referral/types"
+	stake "git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// Event types and attribute keys emitted by the ovg-chain modules
+const (
+	EventTypeTransfer   = banktypes.EventTypeTransfer
+	EventTypeFeeCharged = "fee_charged"
+	EventTypeRewardPaid = "reward_paid"
+
+	AttributeKeyAmount    = sdk.AttributeKeyAmount
+	AttributeKeyPayer     = "payer"
+	AttributeKeyRecipient = banktypes.AttributeKeyRecipient
+	AttributeKeySender    = banktypes.AttributeKeySender
+)
+
+// IsOverGoldMsg tells whether the given message is handled by the OverGold modules,
+// and the events emitted while executing it must be indexed
+func IsOverGoldMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *allowed.MsgCreateAddresses, *allowed.MsgUpdateAddresses,
+		*allowed.MsgDeleteByAddresses, *allowed.MsgDeleteByID:
+		return true
+
+	case *banktypes.MsgSend, *banktypes.MsgMultiSend:
+		return true
+
+	case *core.MsgIssue, *core.MsgWithdraw, *core.MsgSend:
+		return true
+
+	case *feeexcluder.MsgCreateAddress, *feeexcluder.MsgUpdateAddress, *feeexcluder.MsgDeleteAddress,
+		*feeexcluder.MsgCreateTariffs, *feeexcluder.MsgUpdateTariffs, *feeexcluder.MsgDeleteTariffs:
+		return true
+
+	case *referral.MsgSetReferrer:
+		return true
+
+	case *stake.MsgSellRequest, *stake.MsgBuyRequest, *stake.MsgMsgCancelSell,
+		*stake.MsgClaimReward, *stake.MsgDistributeRewards,
+		*stake.MsgTransferFromUser, *stake.MsgTransferToUser,
+		*stake.MsgCreateSystemStakeAccountAddress, *stake.MsgUpdateSystemStakeAccountAddress,
+		*stake.MsgDeleteSystemStakeAccountAddress, *stake.MsgManageSystemStake:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// Parse returns all the typed events that have been emitted while executing
+// the message having the given index inside the provided transaction
+func Parse(tx *juno.Tx, index int) (types.MsgEvents, error) {
+	var res types.MsgEvents
+	if index < 0 || index >= len(tx.Logs) {
+		return res, nil
+	}
+
+	for _, event := range tx.Logs[index].Events {
+		switch event.Type {
+		case EventTypeTransfer:
+			for _, attrs := range splitAttributes(event.Attributes) {
+				amount, err := parseAmount(attrs[AttributeKeyAmount])
+				if err != nil {
+					return types.MsgEvents{}, fmt.Errorf("error while parsing %s event: %s", event.Type, err)
+				}
+
+				res.Transfers = append(res.Transfers, types.NewTransferEvent(
+					tx.TxHash, index, len(res.Transfers), attrs[AttributeKeySender], attrs[AttributeKeyRecipient], amount,
+				))
+			}
+
+		case EventTypeFeeCharged:
+			for _, attrs := range splitAttributes(event.Attributes) {
+				amount, err := parseAmount(attrs[AttributeKeyAmount])
+				if err != nil {
+					return types.MsgEvents{}, fmt.Errorf("error while parsing %s event: %s", event.Type, err)
+				}
+
+				res.FeesCharged = append(res.FeesCharged, types.NewFeeChargedEvent(
+					tx.TxHash, index, len(res.FeesCharged), attrs[AttributeKeyPayer], amount,
+				))
+			}
+
+		case EventTypeRewardPaid:
+			for _, attrs := range splitAttributes(event.Attributes) {
+				amount, err := parseAmount(attrs[AttributeKeyAmount])
+				if err != nil {
+					return types.MsgEvents{}, fmt.Errorf("error while parsing %s event: %s", event.Type, err)
+				}
+
+				res.RewardsPaid = append(res.RewardsPaid, types.NewRewardPaidEvent(
+					tx.TxHash, index, len(res.RewardsPaid), attrs[AttributeKeyRecipient], amount,
+				))
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// splitAttributes splits the attributes of a message log event into the original events.
+// Message logs merge all the events having the same type into a single one, so a new
+// event starts every time an attribute key is repeated.
+func splitAttributes(attributes []sdk.Attribute) []map[string]string {
+	var res []map[string]string
+
+	current := make(map[string]string)
+	for _, attr := range attributes {
+		if _, found := current[attr.Key]; found {
+			res = append(res, current)
+			current = make(map[string]string)
+		}
+
+		current[attr.Key] = attr.Value
+	}
+
+	if len(current) > 0 {
+		res = append(res, current)
+	}
+
+	return res
+}
+
+// parseAmount parses the given amount attribute value into coins
+func parseAmount(value string) (sdk.Coins, error) {
+	if value == "" {
+		return sdk.NewCoins(), nil
+	}
+
+	return sdk.ParseCoinsNormalized(value)
+}
@@ -0,0 +1,186 @@
+package feeexcluder
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// QuoteRequest contains the data needed to compute the fee of a transfer
+type QuoteRequest struct {
+	Denom  string
+	Amount sdkmath.Int
+
+	// Excluded tells whether the sender or the receiver are excluded from fees
+	Excluded bool
+
+	// Referrer is the referrer of the sender, if any, along with its balance
+	Referrer        string
+	ReferrerBalance sdk.Coins
+}
+
+// Quote computes the fee breakdown of a transfer given the tariffs of its denom.
+//
+// The applicable tariff is the one with the highest amount not greater than the transferred amount,
+// and inside it the applicable fees are the ones with the highest amount_from not greater than the
+// transferred amount. The fee is the fee rate applied to the transferred amount, raised to min_amount;
+// the referrer gets ref_reward of it unless no_ref_reward is set, the sender has no referrer or the
+// referrer holds less than min_ref_balance, and the stakers get stake_reward of it.
+// Whatever is left of the fee goes to the system.
+func Quote(tariffs []*fe.Tariff, req QuoteRequest) (types.FeeQuote, error) {
+	if req.Amount.IsNegative() {
+		return types.FeeQuote{}, fmt.Errorf("invalid amount: %s", req.Amount)
+	}
+
+	if req.Excluded {
+		return types.NewZeroFeeQuote(req.Denom, req.Amount, true), nil
+	}
+
+	tariff, err := applicableTariff(tariffs, req.Amount)
+	if err != nil || tariff == nil {
+		return types.NewZeroFeeQuote(req.Denom, req.Amount, false), err
+	}
+
+	fees, err := applicableFees(tariff.Fees, req.Amount)
+	if err != nil || fees == nil {
+		return types.NewZeroFeeQuote(req.Denom, req.Amount, false), err
+	}
+
+	quote := types.NewZeroFeeQuote(req.Denom, req.Amount, false)
+	quote.TariffID = tariff.Id
+	quote.FeesID = fees.Id
+
+	if quote.Fee, err = applyRate(req.Amount, fees.Fee); err != nil {
+		return types.FeeQuote{}, err
+	}
+
+	if minAmount := sdkmath.NewIntFromUint64(fees.MinAmount); quote.Fee.LT(minAmount) {
+		quote.Fee = minAmount
+	}
+
+	if refRewarded(tariff, fees, req) {
+		if quote.RefReward, err = applyRate(quote.Fee, fees.RefReward); err != nil {
+			return types.FeeQuote{}, err
+		}
+	}
+
+	if quote.StakeReward, err = applyRate(quote.Fee, fees.StakeReward); err != nil {
+		return types.FeeQuote{}, err
+	}
+
+	quote.SystemReward = quote.Fee.Sub(quote.RefReward).Sub(quote.StakeReward)
+	if quote.SystemReward.IsNegative() {
+		return types.FeeQuote{}, fmt.Errorf("rewards of fees %d exceed the fee", fees.Id)
+	}
+
+	return quote, nil
+}
+
+// applicableTariff returns the tariff with the highest amount not greater than the given one
+func applicableTariff(tariffs []*fe.Tariff, amount sdkmath.Int) (*fe.Tariff, error) {
+	var (
+		res       *fe.Tariff
+		resAmount sdkmath.Int
+	)
+
+	for _, tariff := range tariffs {
+		if tariff == nil {
+			continue
+		}
+
+		tariffAmount, err := parseInt(tariff.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of tariff %d: %s", tariff.Id, err)
+		}
+
+		if tariffAmount.GT(amount) || (res != nil && tariffAmount.LTE(resAmount)) {
+			continue
+		}
+
+		res, resAmount = tariff, tariffAmount
+	}
+
+	return res, nil
+}
+
+// applicableFees returns the fees with the highest amount_from not greater than the given amount
+func applicableFees(fees []*fe.Fees, amount sdkmath.Int) (*fe.Fees, error) {
+	var (
+		res     *fe.Fees
+		resFrom sdkmath.Int
+	)
+
+	for _, f := range fees {
+		if f == nil {
+			continue
+		}
+
+		from, err := parseInt(f.AmountFrom)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount_from of fees %d: %s", f.Id, err)
+		}
+
+		if from.GT(amount) || (res != nil && from.LTE(resFrom)) {
+			continue
+		}
+
+		res, resFrom = f, from
+	}
+
+	return res, nil
+}
+
+// refRewarded tells whether the referrer of the sender gets a reward
+func refRewarded(tariff *fe.Tariff, fees *fe.Fees, req QuoteRequest) bool {
+	if fees.NoRefReward || req.Referrer == "" {
+		return false
+	}
+
+	minRefBalance, err := parseInt(tariff.MinRefBalance)
+	if err != nil {
+		return false
+	}
+
+	denom := tariff.Denom
+	if denom == "" {
+		denom = req.Denom
+	}
+
+	return req.ReferrerBalance.AmountOf(denom).GTE(minRefBalance)
+}
+
+// applyRate returns the given amount multiplied by the given decimal rate, truncated
+func applyRate(amount sdkmath.Int, rate string) (sdkmath.Int, error) {
+	if rate == "" {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	dec, err := sdk.NewDecFromStr(rate)
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("invalid rate %s: %s", rate, err)
+	}
+
+	if dec.IsNegative() {
+		return sdkmath.Int{}, fmt.Errorf("invalid rate %s: must not be negative", rate)
+	}
+
+	return sdk.NewDecFromInt(amount).Mul(dec).TruncateInt(), nil
+}
+
+// parseInt parses the given integer, treating an empty value as zero
+func parseInt(value string) (sdkmath.Int, error) {
+	if value == "" {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	res, ok := sdkmath.NewIntFromString(value)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("invalid integer: %s", value)
+	}
+
+	return res, nil
+}
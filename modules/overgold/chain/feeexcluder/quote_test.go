@@ -0,0 +1,168 @@
+package feeexcluder_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/feeexcluder"
+)
+
+const (
+	denom    = "ovg"
+	referrer = "ovg1referrer"
+)
+
+func testTariffs() []*fe.Tariff {
+	return []*fe.Tariff{
+		{
+			Id:            1,
+			Amount:        "0",
+			Denom:         denom,
+			MinRefBalance: "1000",
+			Fees: []*fe.Fees{
+				{Id: 1, AmountFrom: "0", Fee: "0.1", RefReward: "0.5", StakeReward: "0.2", MinAmount: 5},
+				{Id: 2, AmountFrom: "1000", Fee: "0.05", RefReward: "0.5", StakeReward: "0.2", MinAmount: 5},
+				{Id: 3, AmountFrom: "5000", Fee: "0.01", RefReward: "0.5", StakeReward: "0.2", NoRefReward: true},
+			},
+		},
+		{
+			Id:            2,
+			Amount:        "100000",
+			Denom:         denom,
+			MinRefBalance: "0",
+			Fees: []*fe.Fees{
+				{Id: 4, AmountFrom: "0", Fee: "0.001", RefReward: "0.1", StakeReward: "0.1"},
+			},
+		},
+	}
+}
+
+func TestQuote(t *testing.T) {
+	richReferrer := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	poorReferrer := sdk.NewCoins(sdk.NewInt64Coin(denom, 999))
+
+	testCases := []struct {
+		name      string
+		tariffs   []*fe.Tariff
+		req       feeexcluder.QuoteRequest
+		tariffID  uint64
+		feesID    uint64
+		fee       int64
+		ref       int64
+		stake     int64
+		system    int64
+		excluded  bool
+		shouldErr bool
+	}{
+		{
+			name:     "excluded address pays no fee",
+			tariffs:  testTariffs(),
+			req:      feeexcluder.QuoteRequest{Denom: denom, Amount: sdkmath.NewInt(500), Excluded: true},
+			excluded: true,
+		},
+		{
+			name:    "no tariffs pays no fee",
+			tariffs: nil,
+			req:     feeexcluder.QuoteRequest{Denom: denom, Amount: sdkmath.NewInt(500)},
+		},
+		{
+			name:     "lowest fees without referrer",
+			tariffs:  testTariffs(),
+			req:      feeexcluder.QuoteRequest{Denom: denom, Amount: sdkmath.NewInt(500)},
+			tariffID: 1, feesID: 1, fee: 50, ref: 0, stake: 10, system: 40,
+		},
+		{
+			name:    "referrer with enough balance gets ref reward",
+			tariffs: testTariffs(),
+			req: feeexcluder.QuoteRequest{
+				Denom: denom, Amount: sdkmath.NewInt(500), Referrer: referrer, ReferrerBalance: richReferrer,
+			},
+			tariffID: 1, feesID: 1, fee: 50, ref: 25, stake: 10, system: 15,
+		},
+		{
+			name:    "referrer below min ref balance gets nothing",
+			tariffs: testTariffs(),
+			req: feeexcluder.QuoteRequest{
+				Denom: denom, Amount: sdkmath.NewInt(500), Referrer: referrer, ReferrerBalance: poorReferrer,
+			},
+			tariffID: 1, feesID: 1, fee: 50, ref: 0, stake: 10, system: 40,
+		},
+		{
+			name:     "fee is raised to min amount",
+			tariffs:  testTariffs(),
+			req:      feeexcluder.QuoteRequest{Denom: denom, Amount: sdkmath.NewInt(20)},
+			tariffID: 1, feesID: 1, fee: 5, ref: 0, stake: 1, system: 4,
+		},
+		{
+			name:    "amount from selects the highest matching fees",
+			tariffs: testTariffs(),
+			req: feeexcluder.QuoteRequest{
+				Denom: denom, Amount: sdkmath.NewInt(2000), Referrer: referrer, ReferrerBalance: richReferrer,
+			},
+			tariffID: 1, feesID: 2, fee: 100, ref: 50, stake: 20, system: 30,
+		},
+		{
+			name:    "no ref reward fees skip the referrer",
+			tariffs: testTariffs(),
+			req: feeexcluder.QuoteRequest{
+				Denom: denom, Amount: sdkmath.NewInt(10000), Referrer: referrer, ReferrerBalance: richReferrer,
+			},
+			tariffID: 1, feesID: 3, fee: 100, ref: 0, stake: 20, system: 80,
+		},
+		{
+			name:    "tariff amount selects the highest matching tariff",
+			tariffs: testTariffs(),
+			req: feeexcluder.QuoteRequest{
+				Denom: denom, Amount: sdkmath.NewInt(200000), Referrer: referrer, ReferrerBalance: poorReferrer,
+			},
+			tariffID: 2, feesID: 4, fee: 200, ref: 20, stake: 20, system: 160,
+		},
+		{
+			name: "invalid fee rate returns error",
+			tariffs: []*fe.Tariff{
+				{Id: 1, Amount: "0", Fees: []*fe.Fees{{Id: 1, AmountFrom: "0", Fee: "abc"}}},
+			},
+			req:       feeexcluder.QuoteRequest{Denom: denom, Amount: sdkmath.NewInt(500)},
+			shouldErr: true,
+		},
+		{
+			name: "rewards exceeding the fee return error",
+			tariffs: []*fe.Tariff{
+				{Id: 1, Amount: "0", Fees: []*fe.Fees{{Id: 1, AmountFrom: "0", Fee: "0.1", StakeReward: "1.5"}}},
+			},
+			req:       feeexcluder.QuoteRequest{Denom: denom, Amount: sdkmath.NewInt(500)},
+			shouldErr: true,
+		},
+		{
+			name:      "negative amount returns error",
+			tariffs:   testTariffs(),
+			req:       feeexcluder.QuoteRequest{Denom: denom, Amount: sdkmath.NewInt(-1)},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			quote, err := feeexcluder.Quote(tc.tariffs, tc.req)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.excluded, quote.Excluded)
+			require.Equal(t, tc.tariffID, quote.TariffID)
+			require.Equal(t, tc.feesID, quote.FeesID)
+			require.Equal(t, sdkmath.NewInt(tc.fee), quote.Fee)
+			require.Equal(t, sdkmath.NewInt(tc.ref), quote.RefReward)
+			require.Equal(t, sdkmath.NewInt(tc.stake), quote.StakeReward)
+			require.Equal(t, sdkmath.NewInt(tc.system), quote.SystemReward)
+			require.Equal(t, tc.req.Amount.AddRaw(tc.fee), quote.Total())
+		})
+	}
+}
@@ -1,8 +1,15 @@
 package local
 
 import (
+	"context"
+	"fmt"
+
 	feeexcludertypes "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/forbole/juno/v5/node/local"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/feeexcluder/source"
 )
@@ -25,7 +32,94 @@ func NewSource(source *local.Source, feeexcluderServer feeexcludertypes.QuerySer
 	}
 }
 
-// GetFees implements Source
-func (s Source) GetFees(denom []string, height int64) ([]*feeexcludertypes.Fees, error) {
-	return nil, nil
+// getTariffs returns the tariffs of the given denoms, or all of them when no denom is given
+func (s Source) getTariffs(ctx context.Context, denoms []string) ([]*feeexcludertypes.Tariffs, error) {
+	if len(denoms) == 0 {
+		var tariffs []*feeexcludertypes.Tariffs
+		var nextKey []byte
+		var stop = false
+		for !stop {
+			res, err := s.feeexcluderServer.TariffsAll(
+				ctx,
+				&feeexcludertypes.QueryAllTariffsRequest{
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: 100, // Query 100 tariffs at time
+					},
+				})
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range res.Tariffs {
+				tariffs = append(tariffs, &res.Tariffs[i])
+			}
+
+			nextKey = res.Pagination.GetNextKey()
+			stop = len(nextKey) == 0
+		}
+
+		return tariffs, nil
+	}
+
+	tariffs := make([]*feeexcludertypes.Tariffs, 0, len(denoms))
+	for _, denom := range denoms {
+		res, err := s.feeexcluderServer.Tariffs(ctx, &feeexcludertypes.QueryGetTariffsRequest{Denom: denom})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			return nil, err
+		}
+
+		t := res.Tariffs
+		tariffs = append(tariffs, &t)
+	}
+
+	return tariffs, nil
+}
+
+// GetTariffs implements Source
+func (s Source) GetTariffs(denoms []string, height int64) ([]*feeexcludertypes.Tariffs, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	return s.getTariffs(sdk.WrapSDKContext(ctx), denoms)
+}
+
+// GetAddresses implements Source
+func (s Source) GetAddresses(height int64) ([]*feeexcludertypes.Address, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	var addresses []*feeexcludertypes.Address
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.feeexcluderServer.AddressAll(
+			sdk.WrapSDKContext(ctx),
+			&feeexcludertypes.QueryAllAddressRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 addresses at time
+				},
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Address {
+			addresses = append(addresses, &res.Address[i])
+		}
+
+		nextKey = res.Pagination.GetNextKey()
+		stop = len(nextKey) == 0
+	}
+
+	return addresses, nil
 }
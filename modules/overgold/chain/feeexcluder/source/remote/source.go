@@ -1,7 +1,13 @@
 package remote
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/forbole/juno/v5/node/remote"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/feeexcluder/source"
 
@@ -26,7 +32,86 @@ func NewSource(source *remote.Source, feeexcluderClient feeexcludertypes.QueryCl
 	}
 }
 
-// GetFees implements Source
-func (s Source) GetFees(denom []string, height int64) ([]*feeexcludertypes.Fees, error) {
-	return []*feeexcludertypes.Fees{}, nil
+// getTariffs returns the tariffs of the given denoms, or all of them when no denom is given
+func (s Source) getTariffs(ctx context.Context, denoms []string) ([]*feeexcludertypes.Tariffs, error) {
+	if len(denoms) == 0 {
+		var tariffs []*feeexcludertypes.Tariffs
+		var nextKey []byte
+		var stop = false
+		for !stop {
+			res, err := s.client.TariffsAll(
+				ctx,
+				&feeexcludertypes.QueryAllTariffsRequest{
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: 100, // Query 100 tariffs at time
+					},
+				})
+			if err != nil {
+				return nil, fmt.Errorf("error while getting tariffs: %s", err)
+			}
+
+			for i := range res.Tariffs {
+				tariffs = append(tariffs, &res.Tariffs[i])
+			}
+
+			nextKey = res.Pagination.GetNextKey()
+			stop = len(nextKey) == 0
+		}
+
+		return tariffs, nil
+	}
+
+	tariffs := make([]*feeexcludertypes.Tariffs, 0, len(denoms))
+	for _, denom := range denoms {
+		res, err := s.client.Tariffs(ctx, &feeexcludertypes.QueryGetTariffsRequest{Denom: denom})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			return nil, fmt.Errorf("error while getting tariffs of %s: %s", denom, err)
+		}
+
+		t := res.Tariffs
+		tariffs = append(tariffs, &t)
+	}
+
+	return tariffs, nil
+}
+
+// GetTariffs implements Source
+func (s Source) GetTariffs(denoms []string, height int64) ([]*feeexcludertypes.Tariffs, error) {
+	return s.getTariffs(remote.GetHeightRequestContext(s.Ctx, height), denoms)
+}
+
+// GetAddresses implements Source
+func (s Source) GetAddresses(height int64) ([]*feeexcludertypes.Address, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	var addresses []*feeexcludertypes.Address
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.client.AddressAll(
+			ctx,
+			&feeexcludertypes.QueryAllAddressRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 addresses at time
+				},
+			})
+		if err != nil {
+			return nil, fmt.Errorf("error while getting excluded addresses: %s", err)
+		}
+
+		for i := range res.Address {
+			addresses = append(addresses, &res.Address[i])
+		}
+
+		nextKey = res.Pagination.GetNextKey()
+		stop = len(nextKey) == 0
+	}
+
+	return addresses, nil
 }
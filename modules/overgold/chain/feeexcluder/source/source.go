@@ -5,5 +5,9 @@ import (
 )
 
 type Source interface {
-	GetFees(denom []string, height int64) ([]*feeexcludertypes.Fees, error)
+	// GetTariffs returns the tariffs of the given denoms, or all of them when no denom is given
+	GetTariffs(denoms []string, height int64) ([]*feeexcludertypes.Tariffs, error)
+
+	// GetAddresses returns all the addresses excluded from the fees
+	GetAddresses(height int64) ([]*feeexcludertypes.Address, error)
 }
@@ -0,0 +1,127 @@
+package feeexcluder
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+	"git.ooo.ua/vipcoin/lib/filter"
+	fe "git.ooo.ua/vipcoin/ovg-chain/x/feeexcluder/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	stateKeyTariffs = "tariffs/"
+	stateKeyAddress = "address/"
+)
+
+// RefreshState compares the tariffs and the excluded addresses stored inside the database with the
+// ones of the node at the given height, returning the differences. When overwrite is true, the
+// differing tariffs are re-imported and the stored addresses are replaced with the node ones.
+func (m *Module) RefreshState(height int64, overwrite bool) ([]types.OverGoldStateDiff, error) {
+	tariffsDiffs, nodeTariffs, err := m.diffTariffs(height)
+	if err != nil {
+		return nil, err
+	}
+
+	addressDiffs, nodeAddresses, err := m.diffAddresses(height)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := append(tariffsDiffs, addressDiffs...)
+	if !overwrite {
+		return diffs, nil
+	}
+
+	if err = types.CheckOverGoldStateOverwrite(tariffsDiffs, len(nodeTariffs)); err != nil {
+		return nil, err
+	}
+
+	if err = types.CheckOverGoldStateOverwrite(addressDiffs, len(nodeAddresses)); err != nil {
+		return nil, err
+	}
+
+	for _, diff := range tariffsDiffs {
+		denom := strings.TrimPrefix(diff.Key, stateKeyTariffs)
+		if err = m.feeexcluderRepo.ReplaceTariffs(denom, nodeTariffs[denom]); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addressDiffs) > 0 {
+		if err = m.feeexcluderRepo.ReplaceAddresses(nodeAddresses...); err != nil {
+			return nil, err
+		}
+	}
+
+	return diffs, nil
+}
+
+// diffTariffs compares the stored tariffs with the node ones, returning the differences
+// along with the node tariffs indexed by denom
+func (m *Module) diffTariffs(height int64) ([]types.OverGoldStateDiff, map[string]*fe.Tariffs, error) {
+	indexedTariffs, err := m.feeexcluderRepo.GetAllTariffs(filter.NewFilter())
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, nil, err
+	}
+
+	tariffs, err := m.keeper.GetTariffs(nil, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indexed := make(map[string]string, len(indexedTariffs))
+	for i := range indexedTariffs {
+		value, err := json.Marshal(indexedTariffs[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		indexed[stateKeyTariffs+indexedTariffs[i].Denom] = string(value)
+	}
+
+	nodeTariffs := make(map[string]*fe.Tariffs, len(tariffs))
+	node := make(map[string]string, len(tariffs))
+	for _, t := range tariffs {
+		value, err := json.Marshal(t)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nodeTariffs[t.Denom] = t
+		node[stateKeyTariffs+t.Denom] = string(value)
+	}
+
+	return types.DiffOverGoldState(m.Name(), indexed, node), nodeTariffs, nil
+}
+
+// diffAddresses compares the stored excluded addresses with the node ones, returning the differences
+// along with the node addresses
+func (m *Module) diffAddresses(height int64) ([]types.OverGoldStateDiff, []fe.Address, error) {
+	indexedAddresses, err := m.feeexcluderRepo.GetAllAddress(filter.NewFilter())
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, nil, err
+	}
+
+	addresses, err := m.keeper.GetAddresses(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indexed := make(map[string]string, len(indexedAddresses))
+	for _, a := range indexedAddresses {
+		indexed[stateKeyAddress+a.Address] = a.Creator
+	}
+
+	nodeAddresses := make([]fe.Address, 0, len(addresses))
+	node := make(map[string]string, len(addresses))
+	for _, a := range addresses {
+		nodeAddresses = append(nodeAddresses, *a)
+		node[stateKeyAddress+a.Address] = a.Creator
+	}
+
+	return types.DiffOverGoldState(m.Name(), indexed, node), nodeAddresses, nil
+}
@@ -3,13 +3,19 @@ package referral
 import (
 	referral "git.ooo.ua/vipcoin/ovg-chain/x/referral/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
 )
 
 // handleMsgSetReferrer allows to properly handle a MsgSetReferrer
 func (m *Module) handleMsgSetReferrer(tx *juno.Tx, _ int, msg *referral.MsgSetReferrer) error {
-	return m.referralRepo.InsertMsgSetReferrer(tx.TxHash, referral.MsgSetReferrer{
+	if err := m.referralRepo.InsertMsgSetReferrer(tx.TxHash, referral.MsgSetReferrer{
 		Creator:         msg.Creator,
 		ReferrerAddress: msg.ReferrerAddress,
 		ReferralAddress: msg.ReferralAddress,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return m.referralRepo.SaveReferralLinks(types.NewReferralLink(msg.ReferralAddress, msg.ReferrerAddress, tx.Height))
 }
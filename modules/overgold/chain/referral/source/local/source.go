@@ -1,8 +1,13 @@
 package local
 
 import (
+	"fmt"
+
 	referraltypes "git.ooo.ua/vipcoin/ovg-chain/x/referral/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/forbole/juno/v5/node/local"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/referral/source"
 )
@@ -29,3 +34,22 @@ func NewSource(source *local.Source, referralServer referraltypes.QueryServer) *
 func (s Source) GetStats(dates []string, height int64) ([]*referraltypes.Stats, error) {
 	return nil, nil
 }
+
+// GetReferrer implements Source
+func (s Source) GetReferrer(address string, height int64) (string, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return "", fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.referralServer.User(sdk.WrapSDKContext(ctx), &referraltypes.QueryGetUserRequest{AccountAddress: address})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return res.User.Referrer, nil
+}
@@ -1,7 +1,11 @@
 package remote
 
 import (
+	"fmt"
+
 	"github.com/forbole/juno/v5/node/remote"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/referral/source"
 
@@ -30,3 +34,19 @@ func NewSource(source *remote.Source, referralClient referraltypes.QueryClient)
 func (s Source) GetStats(dates []string, height int64) ([]*referraltypes.Stats, error) {
 	return []*referraltypes.Stats{}, nil
 }
+
+// GetReferrer implements Source
+func (s Source) GetReferrer(address string, height int64) (string, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	res, err := s.client.User(ctx, &referraltypes.QueryGetUserRequest{AccountAddress: address})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("error while getting referral user: %s", err)
+	}
+
+	return res.User.Referrer, nil
+}
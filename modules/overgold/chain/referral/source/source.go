@@ -6,4 +6,7 @@ import (
 
 type Source interface {
 	GetStats(dates []string, height int64) ([]*referraltypes.Stats, error)
+
+	// GetReferrer returns the referrer of the given address, or an empty string when it has none
+	GetReferrer(address string, height int64) (string, error)
 }
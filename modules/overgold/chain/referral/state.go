@@ -0,0 +1,58 @@
+package referral
+
+import (
+	"errors"
+
+	"git.ooo.ua/vipcoin/lib/errs"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// RefreshState compares the referrers stored inside the database with the ones of the node at the
+// given height, returning the differences. Since the node can only be queried by address, only the
+// addresses already known are checked. When overwrite is true, the stored referrers are updated to
+// match the node ones.
+func (m *Module) RefreshState(height int64, overwrite bool) ([]types.OverGoldStateDiff, error) {
+	links, err := m.referralRepo.GetReferralLinks()
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, err
+	}
+
+	indexed := make(map[string]string, len(links))
+	node := make(map[string]string, len(links))
+	for _, link := range links {
+		indexed[link.ReferralAddress] = link.ReferrerAddress
+
+		referrer, err := m.keeper.GetReferrer(link.ReferralAddress, height)
+		if err != nil {
+			return nil, err
+		}
+
+		if referrer != "" {
+			node[link.ReferralAddress] = referrer
+		}
+	}
+
+	diffs := types.DiffOverGoldState(m.Name(), indexed, node)
+	if !overwrite {
+		return diffs, nil
+	}
+
+	if err = types.CheckOverGoldStateOverwrite(diffs, len(node)); err != nil {
+		return nil, err
+	}
+
+	var stale []string
+	for _, diff := range diffs {
+		if diff.Kind == types.StateDiffStale {
+			stale = append(stale, diff.Key)
+			continue
+		}
+
+		if err = m.referralRepo.SaveReferralLinks(types.NewReferralLink(diff.Key, diff.Node, height)); err != nil {
+			return nil, err
+		}
+	}
+
+	return diffs, m.referralRepo.DeleteReferralLinks(stale...)
+}
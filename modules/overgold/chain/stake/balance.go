@@ -0,0 +1,41 @@
+package stake
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// storeBalanceChange updates the stake of the given address with the changes caused by the message having
+// the given index: amount is added to the staked amount, and sellAmount to the amount put on sale
+func (m *Module) storeBalanceChange(tx *juno.Tx, index int, address string, amount, sellAmount sdkmath.Int) error {
+	return m.stakeRepo.InsertStakeBalanceChanges(
+		types.NewStakeBalanceChange(tx.TxHash, index, tx.Height, address, amount, sellAmount))
+}
+
+// storeTransferBalanceChanges updates the stake of both the addresses involved in the given transfer
+func (m *Module) storeTransferBalanceChanges(tx *juno.Tx, index int, from, to string, amount sdkmath.Int) error {
+	// A transfer to the same address does not change its stake
+	if from == to {
+		return nil
+	}
+
+	return m.stakeRepo.InsertStakeBalanceChanges(
+		types.NewStakeBalanceChange(tx.TxHash, index, tx.Height, from, amount.Neg(), sdk.ZeroInt()),
+		types.NewStakeBalanceChange(tx.TxHash, index, tx.Height, to, amount, sdk.ZeroInt()),
+	)
+}
+
+// parseStakeAmount parses the given stake amount of a message
+func parseStakeAmount(rawAmount string) (sdkmath.Int, error) {
+	amount, ok := sdk.NewIntFromString(rawAmount)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("invalid stake amount: %s", rawAmount)
+	}
+
+	return amount, nil
+}
@@ -2,13 +2,23 @@ package stake
 
 import (
 	"git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	juno "github.com/forbole/juno/v5/types"
 )
 
 // handleMsgBuy allows to properly handle a stake buy message
-func (m *Module) handleMsgBuy(tx *juno.Tx, _ int, msg *types.MsgBuyRequest) error {
-	return m.stakeRepo.InsertMsgBuy(tx.TxHash, types.MsgBuyRequest{
+func (m *Module) handleMsgBuy(tx *juno.Tx, index int, msg *types.MsgBuyRequest) error {
+	if err := m.stakeRepo.InsertMsgBuy(tx.TxHash, types.MsgBuyRequest{
 		Creator: msg.Creator,
 		Amount:  msg.Amount,
-	})
+	}); err != nil {
+		return err
+	}
+
+	amount, err := parseStakeAmount(msg.Amount)
+	if err != nil {
+		return err
+	}
+
+	return m.storeBalanceChange(tx, index, msg.Creator, amount, sdk.ZeroInt())
 }
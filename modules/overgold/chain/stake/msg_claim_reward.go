@@ -1,13 +1,58 @@
 package stake
 
 import (
+	"fmt"
+	"time"
+
 	"git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/events"
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
 // handleMsgClaimReward allows to properly handle a stake claim reward message
-func (m *Module) handleMsgClaimReward(tx *juno.Tx, _ int, msg *types.MsgClaimReward) error {
-	return m.stakeRepo.InsertMsgClaimReward(tx.TxHash, types.MsgClaimReward{
+func (m *Module) handleMsgClaimReward(tx *juno.Tx, index int, msg *types.MsgClaimReward) error {
+	amount, err := claimedAmount(tx, index, msg)
+	if err != nil {
+		return err
+	}
+
+	if err = m.stakeRepo.InsertMsgClaimReward(tx.TxHash, types.MsgClaimReward{
 		Creator: msg.Creator,
-	})
+		Amount:  amount,
+	}); err != nil {
+		return err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	return m.stakeRepo.InsertRewardEntries(newRewardEntries(
+		tx, index, msg.Creator, bdtypes.StakeRewardKindClaim, sdk.Coins{amount}, timestamp)...)
+}
+
+// claimedAmount returns the reward amount actually paid to the creator of the given message.
+// The amount is taken from the emitted events when available, since the message amount
+// only represents what has been requested.
+func claimedAmount(tx *juno.Tx, index int, msg *types.MsgClaimReward) (sdk.Coin, error) {
+	msgEvents, err := events.Parse(tx, index)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	paid := msgEvents.TotalRewardsPaid(msg.Creator)
+	switch {
+	case paid.IsZero():
+		return msg.Amount, nil
+	case msg.Amount.Denom == "":
+		return paid[0], nil
+	case paid.AmountOf(msg.Amount.Denom).IsPositive():
+		return sdk.NewCoin(msg.Amount.Denom, paid.AmountOf(msg.Amount.Denom)), nil
+	default:
+		return msg.Amount, nil
+	}
 }
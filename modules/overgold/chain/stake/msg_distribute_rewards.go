@@ -6,8 +6,17 @@ import (
 )
 
 // handleMsgDistributeRewards allows to properly handle a stake distribute rewards message
-func (m *Module) handleMsgDistributeRewards(tx *juno.Tx, _ int, msg *types.MsgDistributeRewards) error {
-	return m.stakeRepo.InsertMsgDistributeRewards(tx.TxHash, types.MsgDistributeRewards{
+func (m *Module) handleMsgDistributeRewards(tx *juno.Tx, index int, msg *types.MsgDistributeRewards) error {
+	if err := m.stakeRepo.InsertMsgDistributeRewards(tx.TxHash, types.MsgDistributeRewards{
 		Creator: msg.Creator,
-	})
+	}); err != nil {
+		return err
+	}
+
+	entries, err := m.distributedRewards(tx, index)
+	if err != nil {
+		return err
+	}
+
+	return m.stakeRepo.InsertRewardEntries(entries...)
 }
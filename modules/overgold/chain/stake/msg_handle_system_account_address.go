@@ -1,21 +1,49 @@
 package stake
 
 import (
+	"fmt"
+	"time"
+
 	"git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
 // handleMsgCreateSystemStakeAccountAddress allows to properly handle a message
-func (m *Module) handleMsgCreateSystemStakeAccountAddress(tx *juno.Tx, _ int, msg *types.MsgCreateSystemStakeAccountAddress) error {
-	return m.stakeRepo.InsertMsgCreateSystemStakeAccountAddress(tx.TxHash, *msg)
+func (m *Module) handleMsgCreateSystemStakeAccountAddress(tx *juno.Tx, index int, msg *types.MsgCreateSystemStakeAccountAddress) error {
+	if err := m.stakeRepo.InsertMsgCreateSystemStakeAccountAddress(tx.TxHash, *msg); err != nil {
+		return err
+	}
+
+	return m.applySystemAccountChange(tx, index, bdtypes.SystemStakeAccountActionCreate, msg.Creator, msg.Address)
 }
 
 // handleMsgUpdateSystemStakeAccountAddress allows to properly handle a message
-func (m *Module) handleMsgUpdateSystemStakeAccountAddress(tx *juno.Tx, _ int, msg *types.MsgUpdateSystemStakeAccountAddress) error {
-	return m.stakeRepo.InsertMsgUpdateSystemStakeAccountAddress(tx.TxHash, *msg)
+func (m *Module) handleMsgUpdateSystemStakeAccountAddress(tx *juno.Tx, index int, msg *types.MsgUpdateSystemStakeAccountAddress) error {
+	if err := m.stakeRepo.InsertMsgUpdateSystemStakeAccountAddress(tx.TxHash, *msg); err != nil {
+		return err
+	}
+
+	return m.applySystemAccountChange(tx, index, bdtypes.SystemStakeAccountActionUpdate, msg.Creator, msg.Address)
 }
 
 // handleMsgDeleteSystemStakeAccountAddress allows to properly handle a message
-func (m *Module) handleMsgDeleteSystemStakeAccountAddress(tx *juno.Tx, _ int, msg *types.MsgDeleteSystemStakeAccountAddress) error {
-	return m.stakeRepo.InsertMsgDeleteSystemStakeAccountAddress(tx.TxHash, *msg)
+func (m *Module) handleMsgDeleteSystemStakeAccountAddress(tx *juno.Tx, index int, msg *types.MsgDeleteSystemStakeAccountAddress) error {
+	if err := m.stakeRepo.InsertMsgDeleteSystemStakeAccountAddress(tx.TxHash, *msg); err != nil {
+		return err
+	}
+
+	return m.applySystemAccountChange(tx, index, bdtypes.SystemStakeAccountActionDelete, msg.Creator, "")
+}
+
+// applySystemAccountChange stores the given change inside the system stake account registry
+func (m *Module) applySystemAccountChange(tx *juno.Tx, index int, action, creator, address string) error {
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	return m.stakeRepo.ApplySystemStakeAccountChange(bdtypes.NewSystemStakeAccountChange(
+		tx.TxHash, index, tx.Height, action, creator, address, timestamp))
 }
@@ -1,11 +1,26 @@
 package stake
 
 import (
+	"fmt"
+
 	"git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
-// handleMsgCreateSystemStakeAccountAddress allows to properly handle a message
-func (m *Module) handleMsgManageSystemStake(tx *juno.Tx, _ int, msg *types.MsgManageSystemStake) error {
-	return m.stakeRepo.InsertMsgManageSystemStake(tx.TxHash, *msg)
+// handleMsgManageSystemStake allows to properly handle a manage system stake message
+func (m *Module) handleMsgManageSystemStake(tx *juno.Tx, index int, msg *types.MsgManageSystemStake) error {
+	if err := m.stakeRepo.InsertMsgManageSystemStake(tx.TxHash, *msg); err != nil {
+		return err
+	}
+
+	amount, ok := sdk.NewIntFromString(msg.Amount)
+	if !ok {
+		return fmt.Errorf("invalid system stake amount: %s", msg.Amount)
+	}
+
+	return m.stakeRepo.ApplySystemStakeChange(bdtypes.NewSystemStakeChange(
+		tx.TxHash, index, tx.Height, msg.Kind, amount))
 }
@@ -5,10 +5,20 @@ import (
 	juno "github.com/forbole/juno/v5/types"
 )
 
-// handleMsgSell allows to properly handle a stake sell message
-func (m *Module) handleMsgSell(tx *juno.Tx, _ int, msg *types.MsgSellRequest) error {
-	return m.stakeRepo.InsertMsgSell(tx.TxHash, types.MsgSellRequest{
+// handleMsgSell allows to properly handle a stake sell message.
+// The amount put on sale is moved from the staked amount to the sell one.
+func (m *Module) handleMsgSell(tx *juno.Tx, index int, msg *types.MsgSellRequest) error {
+	if err := m.stakeRepo.InsertMsgSell(tx.TxHash, types.MsgSellRequest{
 		Creator: msg.Creator,
 		Amount:  msg.Amount,
-	})
+	}); err != nil {
+		return err
+	}
+
+	amount, err := parseStakeAmount(msg.Amount)
+	if err != nil {
+		return err
+	}
+
+	return m.storeBalanceChange(tx, index, msg.Creator, amount.Neg(), amount)
 }
@@ -5,10 +5,19 @@ import (
 	juno "github.com/forbole/juno/v5/types"
 )
 
-// handleMsgSellCancel allows to properly handle a stake sell cancel message
-func (m *Module) handleMsgSellCancel(tx *juno.Tx, _ int, msg *types.MsgMsgCancelSell) error {
-	return m.stakeRepo.InsertMsgSellCancel(tx.TxHash, types.MsgMsgCancelSell{
+// handleMsgSellCancel allows to properly handle a stake sell cancel message.
+// The cancelled amount is moved back from the sell amount to the staked one.
+func (m *Module) handleMsgSellCancel(tx *juno.Tx, index int, msg *types.MsgMsgCancelSell) error {
+	if err := m.stakeRepo.InsertMsgSellCancel(tx.TxHash, types.MsgMsgCancelSell{
 		Creator: msg.Creator,
 		Amount:  msg.Amount,
-	})
+	}); err != nil {
+		return err
+	}
+
+	if msg.Amount.Amount.IsNil() {
+		return nil
+	}
+
+	return m.storeBalanceChange(tx, index, msg.Creator, msg.Amount.Amount, msg.Amount.Amount.Neg())
 }
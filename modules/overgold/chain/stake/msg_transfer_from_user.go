@@ -3,13 +3,19 @@ package stake
 import (
 	"git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
 // handleMsgTransferFromUser allows to properly handle a transfer from user message.
-func (m *Module) handleMsgTransferFromUser(tx *juno.Tx, _ int, msg *types.MsgTransferFromUser) error {
-	return m.stakeRepo.InsertMsgTransferFromUser(tx.TxHash, types.MsgTransferFromUser{
+func (m *Module) handleMsgTransferFromUser(tx *juno.Tx, index int, msg *types.MsgTransferFromUser) error {
+	if err := m.stakeRepo.InsertMsgTransferFromUser(tx.TxHash, types.MsgTransferFromUser{
 		Creator: msg.Creator,
 		Amount:  msg.Amount,
 		Address: msg.Address,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return m.storeTransfer(tx, index, bdtypes.StakeTransferSourceFromUser, msg.Address, msg.Creator, msg.Amount)
 }
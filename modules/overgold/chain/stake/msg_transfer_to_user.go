@@ -3,13 +3,19 @@ package stake
 import (
 	"git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	bdtypes "github.com/forbole/bdjuno/v4/types"
 )
 
 // handleMsgTransferToUser allows to properly handle a transfer to user message.
-func (m *Module) handleMsgTransferToUser(tx *juno.Tx, _ int, msg *types.MsgTransferToUser) error {
-	return m.stakeRepo.InsertMsgTransferToUser(tx.TxHash, types.MsgTransferToUser{
+func (m *Module) handleMsgTransferToUser(tx *juno.Tx, index int, msg *types.MsgTransferToUser) error {
+	if err := m.stakeRepo.InsertMsgTransferToUser(tx.TxHash, types.MsgTransferToUser{
 		Creator: msg.Creator,
 		Amount:  msg.Amount,
 		Address: msg.Address,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return m.storeTransfer(tx, index, bdtypes.StakeTransferSourceToUser, msg.Creator, msg.Address, msg.Amount)
 }
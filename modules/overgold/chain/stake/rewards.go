@@ -0,0 +1,71 @@
+package stake
+
+import (
+	"fmt"
+	"time"
+
+	stake "git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/events"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// distributedRewards returns the per-recipient reward entries produced by the distribution
+// contained inside the message having the given index, taken from the emitted reward paid events.
+// The amounts paid to the same recipient by several events are summed, so that each recipient has
+// a single entry per denom. Distributions without events are logged and skipped, as the node does not
+// expose the rewards of each recipient.
+func (m *Module) distributedRewards(tx *juno.Tx, index int) ([]types.StakeRewardEntry, error) {
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	msgEvents, err := events.Parse(tx, index)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgEvents.RewardsPaid) == 0 {
+		log.Warn().Str("module", stake.ModuleName).Str("tx_hash", tx.TxHash).Int("msg_index", index).
+			Msg("no reward paid events emitted by distribution, skipping rewards")
+		return nil, nil
+	}
+
+	var recipients []string
+	paid := make(map[string]sdk.Coins)
+	for _, reward := range msgEvents.RewardsPaid {
+		if _, ok := paid[reward.Recipient]; !ok {
+			recipients = append(recipients, reward.Recipient)
+		}
+		paid[reward.Recipient] = paid[reward.Recipient].Add(reward.Amount...)
+	}
+
+	var entries []types.StakeRewardEntry
+	for _, recipient := range recipients {
+		entries = append(entries, newRewardEntries(
+			tx, index, recipient, types.StakeRewardKindAccrual, paid[recipient], timestamp)...)
+	}
+
+	return entries, nil
+}
+
+// newRewardEntries builds a ledger entry for each of the positive coins of the given amount
+func newRewardEntries(
+	tx *juno.Tx, index int, address, kind string, amount sdk.Coins, timestamp time.Time,
+) []types.StakeRewardEntry {
+	entries := make([]types.StakeRewardEntry, 0, len(amount))
+	for _, coin := range amount {
+		if !coin.IsPositive() {
+			continue
+		}
+
+		entries = append(entries, types.NewStakeRewardEntry(
+			tx.TxHash, index, tx.Height, address, kind, coin, timestamp))
+	}
+
+	return entries
+}
@@ -1,8 +1,14 @@
 package local
 
 import (
+	"fmt"
+
 	staketypes "git.ooo.ua/vipcoin/ovg-chain/x/stake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/forbole/juno/v5/node/local"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/stake/source"
 )
@@ -27,5 +33,53 @@ func NewSource(source *local.Source, stakeServer staketypes.QueryServer) *Source
 
 // GetStakes implements Source
 func (s Source) GetStakes(address []string, height int64) ([]*staketypes.Stake, error) {
-	return nil, nil
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	if len(address) == 0 {
+		var stakes []*staketypes.Stake
+		var nextKey []byte
+		var stop = false
+		for !stop {
+			res, err := s.stakeServer.StakeAll(
+				sdk.WrapSDKContext(ctx),
+				&staketypes.QueryAllStakeRequest{
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: 100, // Query 100 stakes at time
+					},
+				})
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range res.Stake {
+				stakes = append(stakes, &res.Stake[i])
+			}
+
+			nextKey = res.Pagination.GetNextKey()
+			stop = len(nextKey) == 0
+		}
+
+		return stakes, nil
+	}
+
+	stakes := make([]*staketypes.Stake, 0, len(address))
+	for _, a := range address {
+		res, err := s.stakeServer.Stake(sdk.WrapSDKContext(ctx), &staketypes.QueryGetStakeRequest{Index: a})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			return nil, err
+		}
+
+		stake := res.Stake
+		stakes = append(stakes, &stake)
+	}
+
+	return stakes, nil
 }
@@ -1,7 +1,12 @@
 package remote
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/forbole/juno/v5/node/remote"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/stake/source"
 
@@ -28,5 +33,50 @@ func NewSource(source *remote.Source, stakeClient staketypes.QueryClient) *Sourc
 
 // GetStakes implements Source
 func (s Source) GetStakes(address []string, height int64) ([]*staketypes.Stake, error) {
-	return []*staketypes.Stake{}, nil
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	if len(address) == 0 {
+		var stakes []*staketypes.Stake
+		var nextKey []byte
+		var stop = false
+		for !stop {
+			res, err := s.client.StakeAll(
+				ctx,
+				&staketypes.QueryAllStakeRequest{
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: 100, // Query 100 stakes at time
+					},
+				})
+			if err != nil {
+				return nil, fmt.Errorf("error while getting stakes: %s", err)
+			}
+
+			for i := range res.Stake {
+				stakes = append(stakes, &res.Stake[i])
+			}
+
+			nextKey = res.Pagination.GetNextKey()
+			stop = len(nextKey) == 0
+		}
+
+		return stakes, nil
+	}
+
+	stakes := make([]*staketypes.Stake, 0, len(address))
+	for _, a := range address {
+		res, err := s.client.Stake(ctx, &staketypes.QueryGetStakeRequest{Index: a})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			return nil, fmt.Errorf("error while getting stake of %s: %s", a, err)
+		}
+
+		stake := res.Stake
+		stakes = append(stakes, &stake)
+	}
+
+	return stakes, nil
 }
@@ -5,5 +5,6 @@ import (
 )
 
 type Source interface {
+	// GetStakes returns the stakes of the given addresses, or all of them when no address is given
 	GetStakes(address []string, height int64) ([]*staketypes.Stake, error)
 }
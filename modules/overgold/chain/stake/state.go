@@ -0,0 +1,69 @@
+package stake
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"git.ooo.ua/vipcoin/lib/errs"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// RefreshState compares the stakes kept up to date by the stake messages with the ones of the node at the
+// given height, returning the differences. When overwrite is true, the stored stakes are updated to match
+// the node ones, and the messages up to the given height are not applied to them anymore.
+func (m *Module) RefreshState(height int64, overwrite bool) ([]types.OverGoldStateDiff, error) {
+	balances, err := m.stakeRepo.GetStakeBalances()
+	if err != nil && !errors.As(err, &errs.NotFound{}) {
+		return nil, err
+	}
+
+	stakes, err := m.keeper.GetStakes(nil, height)
+	if err != nil {
+		return nil, err
+	}
+
+	indexed := make(map[string]string, len(balances))
+	for _, balance := range balances {
+		indexed[balance.Address] = stakeValue(balance.Amount, balance.SellAmount)
+	}
+
+	nodeBalances := make(map[string]types.StakeBalance, len(stakes))
+	node := make(map[string]string, len(stakes))
+	for _, stake := range stakes {
+		if stake.Amount.Amount.IsZero() && stake.SellAmount.Amount.IsZero() {
+			continue
+		}
+
+		nodeBalances[stake.Index] = types.NewStakeBalance(stake.Index, stake.Amount.Amount, stake.SellAmount.Amount, height)
+		node[stake.Index] = stakeValue(stake.Amount.Amount, stake.SellAmount.Amount)
+	}
+
+	diffs := types.DiffOverGoldState(m.Name(), indexed, node)
+	if !overwrite {
+		return diffs, nil
+	}
+
+	if err = types.CheckOverGoldStateOverwrite(diffs, len(node)); err != nil {
+		return nil, err
+	}
+
+	var updated []types.StakeBalance
+	var stale []string
+	for _, diff := range diffs {
+		if diff.Kind == types.StateDiffStale {
+			stale = append(stale, diff.Key)
+			continue
+		}
+
+		updated = append(updated, nodeBalances[diff.Key])
+	}
+
+	return diffs, m.stakeRepo.RefreshStakeBalances(height, updated, stale)
+}
+
+// stakeValue returns the value used to compare the stake having the given amounts
+func stakeValue(amount, sellAmount sdkmath.Int) string {
+	return fmt.Sprintf("%s/%s", amount, sellAmount)
+}
@@ -0,0 +1,41 @@
+package stake
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// storeTransfer updates the stake flows and the stake of both the addresses involved in the given transfer
+func (m *Module) storeTransfer(tx *juno.Tx, index int, source, from, to, rawAmount string) error {
+	amount, ok := sdk.NewIntFromString(rawAmount)
+	if !ok {
+		return fmt.Errorf("invalid stake transfer amount: %s", rawAmount)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	err = m.stakeRepo.InsertStakeTransfer(types.NewStakeTransfer(
+		tx.TxHash, index, source, tx.Height, from, to, amount, timestamp))
+	if err != nil {
+		return err
+	}
+
+	return m.storeTransferBalanceChanges(tx, index, from, to, amount)
+}
+
+// RebuildTransferFlows rebuilds the stake flows of all the transfers stored between the given heights
+func (m *Module) RebuildTransferFlows(startHeight, endHeight int64) error {
+	if startHeight > endHeight {
+		return fmt.Errorf("invalid height range: %d > %d", startHeight, endHeight)
+	}
+
+	return m.stakeRepo.RebuildStakeTransferFlows(startHeight, endHeight)
+}
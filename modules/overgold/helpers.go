@@ -212,7 +212,7 @@ func (m *Module) ExportTxs(txs []*types.Tx) ([]*txtypes.Tx, error) {
 		}
 
 		// Handle all the messages contained inside the transaction
-		for _, msg := range tx.Body.Messages {
+		for index, msg := range tx.Body.Messages {
 			var stdMsg sdk.Msg
 			err = m.cdc.UnpackAny(msg, &stdMsg)
 			if err != nil {
@@ -220,9 +220,9 @@ func (m *Module) ExportTxs(txs []*types.Tx) ([]*txtypes.Tx, error) {
 			}
 
 			// Call the handlers
-			for i, mod := range m.overgoldModules {
+			for _, mod := range m.overgoldModules {
 				if messageModule, ok := mod.(modules.MessageModule); ok {
-					err = messageModule.HandleMsg(i, stdMsg, tx)
+					err = messageModule.HandleMsg(index, stdMsg, tx)
 					if err != nil {
 						if errors.As(err, &errs.NotFound{}) {
 							continue
@@ -9,12 +9,14 @@ import (
 	"github.com/forbole/bdjuno/v4/database/overgold/chain/last_block"
 
 	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/activity"
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/allowed"
 	overgoldAllowedSource "github.com/forbole/bdjuno/v4/modules/overgold/chain/allowed/source"
 	customBank "github.com/forbole/bdjuno/v4/modules/overgold/chain/bank"
 	overgoldBankSource "github.com/forbole/bdjuno/v4/modules/overgold/chain/bank/source"
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/core"
 	overgoldCoreSource "github.com/forbole/bdjuno/v4/modules/overgold/chain/core/source"
+	"github.com/forbole/bdjuno/v4/modules/overgold/chain/events"
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/feeexcluder"
 	overgoldFeeExcluderSource "github.com/forbole/bdjuno/v4/modules/overgold/chain/feeexcluder/source"
 	"github.com/forbole/bdjuno/v4/modules/overgold/chain/referral"
@@ -72,6 +74,12 @@ func NewModule(
 
 			// custom SDK modules
 			customBank.NewModule(overGoldBankSource, cdc, db),
+
+			// typed events emitted by the modules above
+			events.NewModule(cdc, db),
+
+			// activity of the addresses involved in the messages handled by the modules above
+			activity.NewModule(cdc, db),
 		},
 	}
 
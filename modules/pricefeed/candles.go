@@ -0,0 +1,170 @@
+package pricefeed
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/providers"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	// maxPricesPerInsert represents the maximum number of prices or candles stored with a single query
+	maxPricesPerInsert = 1000
+)
+
+// buildCandles groups the given prices into the candles of the given period
+func buildCandles(period string, prices []types.TokenPrice) []types.TokenPriceCandle {
+	duration := types.CandlePeriods[period]
+
+	type candleKey struct {
+		unitName  string
+		currency  string
+		timestamp time.Time
+	}
+
+	candles := make(map[candleKey]*types.TokenPriceCandle)
+	var keys []candleKey
+	for _, price := range prices {
+		if price.Timestamp.IsZero() {
+			continue
+		}
+
+		key := candleKey{price.UnitName, price.Currency, price.Timestamp.UTC().Truncate(duration)}
+		if candle, ok := candles[key]; ok {
+			candle.Add(price)
+			continue
+		}
+
+		candle := types.NewTokenPriceCandle(period, key.timestamp, price)
+		candles[key] = &candle
+		keys = append(keys, key)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].timestamp.Before(keys[j].timestamp)
+	})
+
+	result := make([]types.TokenPriceCandle, len(keys))
+	for i, key := range keys {
+		result[i] = *candles[key]
+	}
+	return result
+}
+
+// saveCandles builds and stores the candles of every period containing the given prices
+func (m *Module) saveCandles(prices []types.TokenPrice) error {
+	for _, period := range []string{types.CandlePeriodHourly, types.CandlePeriodDaily} {
+		candles := buildCandles(period, prices)
+		for start := 0; start < len(candles); start += maxPricesPerInsert {
+			end := start + maxPricesPerInsert
+			if end > len(candles) {
+				end = len(candles)
+			}
+
+			err := m.db.SaveTokenPriceCandles(candles[start:end])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RollUpCandles stores the historic prices stored since the last roll up into the hourly and daily candles
+func (m *Module) RollUpCandles() error {
+	log.Debug().
+		Str("module", "pricefeed").
+		Str("operation", "candles").
+		Msg("rolling up token price candles")
+
+	from, err := m.db.GetTokenPriceCandlesEnd()
+	if err != nil {
+		return err
+	}
+
+	if from == nil {
+		return nil
+	}
+
+	err = m.rollUpCandles(*from, time.Now())
+	if err != nil {
+		return fmt.Errorf("error while saving token price candles: %s", err)
+	}
+
+	return nil
+}
+
+// rollUpCandles stores into the hourly and daily candles the historic prices of every day between the given times.
+// Prices are read one day at a time, so that each daily candle is built from all its prices.
+// Rolling up the same prices more than once leaves the candles unchanged.
+func (m *Module) rollUpCandles(from, to time.Time) error {
+	for day := from.UTC().Truncate(24 * time.Hour); day.Before(to); day = day.Add(24 * time.Hour) {
+		prices, err := m.db.GetTokenPricesHistory(day, day.Add(24*time.Hour))
+		if err != nil {
+			return err
+		}
+
+		err = m.saveCandles(prices)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Backfill fetches the prices between the given times from the providers supporting it,
+// and stores them as historic prices before rolling them up into the hourly and daily candles
+func (m *Module) Backfill(from, to time.Time) error {
+	if m.cfg == nil {
+		return fmt.Errorf("pricefeed config is not set")
+	}
+
+	if !from.Before(to) {
+		return fmt.Errorf("invalid backfill range: %s is not before %s", from, to)
+	}
+
+	for _, token := range m.cfg.Tokens {
+		provider, ok := m.providers[token.Provider].(providers.HistoryProvider)
+		if !ok {
+			log.Warn().Str("module", "pricefeed").Str("token", token.Name).Str("provider", token.Provider).
+				Msg("price provider does not support history, skipping backfill")
+			continue
+		}
+
+		for _, unit := range token.Units {
+			if unit.PriceID == "" {
+				continue
+			}
+
+			for _, currency := range m.cfg.Currencies {
+				log.Info().Str("module", "pricefeed").Str("unit", unit.Denom).Str("currency", currency).
+					Time("from", from).Time("to", to).Msg("backfilling token prices")
+
+				prices, err := provider.GetTokenPriceHistory(unit, currency, from, to)
+				if err != nil {
+					return fmt.Errorf("error while getting %s %s price history: %s", unit.Denom, currency, err)
+				}
+
+				for start := 0; start < len(prices); start += maxPricesPerInsert {
+					end := start + maxPricesPerInsert
+					if end > len(prices) {
+						end = len(prices)
+					}
+
+					err = m.db.SaveTokenPricesHistory(prices[start:end])
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return m.rollUpCandles(from, to)
+}
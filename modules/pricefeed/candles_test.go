@@ -0,0 +1,47 @@
+package pricefeed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func TestBuildCandles(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	prices := []types.TokenPrice{
+		types.NewTokenPrice("ovg", "usd", 2, 0, start.Add(30*time.Minute)),
+		types.NewTokenPrice("ovg", "usd", 1, 0, start.Add(2*time.Minute)),
+		types.NewTokenPrice("ovg", "usd", 4, 0, start.Add(10*time.Minute)),
+		types.NewTokenPrice("ovg", "usd", 3, 0, start.Add(70*time.Minute)),
+		types.NewTokenPrice("ovg", "eur", 5, 0, start.Add(5*time.Minute)),
+		types.NewTokenPrice("ovg", "usd", 100, 0, time.Time{}),
+	}
+
+	hourly := buildCandles(types.CandlePeriodHourly, prices)
+	require.Len(t, hourly, 3)
+
+	require.Equal(t, "usd", hourly[0].Currency)
+	require.Equal(t, start, hourly[0].Timestamp)
+	require.Equal(t, 1.0, hourly[0].Open)
+	require.Equal(t, 4.0, hourly[0].High)
+	require.Equal(t, 1.0, hourly[0].Low)
+	require.Equal(t, 2.0, hourly[0].Close)
+	require.Equal(t, start.Add(2*time.Minute), hourly[0].OpenTime)
+	require.Equal(t, start.Add(30*time.Minute), hourly[0].CloseTime)
+
+	require.Equal(t, "eur", hourly[1].Currency)
+	require.Equal(t, 5.0, hourly[1].Close)
+
+	require.Equal(t, start.Add(time.Hour), hourly[2].Timestamp)
+	require.Equal(t, 3.0, hourly[2].Open)
+
+	daily := buildCandles(types.CandlePeriodDaily, prices)
+	require.Len(t, daily, 2)
+	require.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), daily[0].Timestamp)
+	require.Equal(t, 1.0, daily[0].Open)
+	require.Equal(t, 4.0, daily[0].High)
+	require.Equal(t, 3.0, daily[0].Close)
+}
@@ -1,39 +1,118 @@
 package coingecko
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
 	"math"
-	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
+const (
+	// DefaultBaseURL represents the URL of the public CoinGecko APIs
+	DefaultBaseURL = "https://api.coingecko.com/api/v3"
+
+	// Upstream represents the name of the CoinGecko upstream inside the http_clients configuration
+	Upstream = "coingecko"
+)
+
+// Client allows to query the CoinGecko APIs available at a given URL
+type Client struct {
+	baseURL string
+	http    *httpclient.Client
+}
+
+// NewClient returns a new Client instance querying the APIs at the given URL, or the public ones
+// if the URL is empty, using the given HTTP client or the shared CoinGecko one if nil
+func NewClient(baseURL string, client *httpclient.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	if client == nil {
+		client = httpclient.Upstream(Upstream)
+	}
+
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:    client,
+	}
+}
+
 // GetCoinsList allows to fetch from the remote APIs the list of all the supported tokens
 func GetCoinsList() (coins Tokens, err error) {
-	err = queryCoinGecko("/coins/list", &coins)
-	return coins, err
+	return NewClient("", nil).GetCoinsList()
 }
 
-// GetTokensPrices queries the remote APIs to get the token prices of all the tokens having the given ids
+// GetTokensPrices queries the remote APIs to get the USD token prices of all the tokens having the given ids
 func GetTokensPrices(ids []string) ([]types.TokenPrice, error) {
+	return NewClient("", nil).GetTokensPrices(ids, types.DefaultCurrency)
+}
+
+// GetCoinsList allows to fetch from the remote APIs the list of all the supported tokens
+func (c *Client) GetCoinsList() (coins Tokens, err error) {
+	err = c.query("/coins/list", &coins)
+	return coins, err
+}
+
+// GetTokensPrices queries the remote APIs to get the token prices, quoted in the given currency,
+// of all the tokens having the given ids
+func (c *Client) GetTokensPrices(ids []string, currency string) ([]types.TokenPrice, error) {
 	var prices []MarketTicker
-	query := fmt.Sprintf("/coins/markets?vs_currency=usd&ids=%s", strings.Join(ids, ","))
-	err := queryCoinGecko(query, &prices)
+	query := fmt.Sprintf("/coins/markets?vs_currency=%s&ids=%s", url.QueryEscape(currency), strings.Join(ids, ","))
+	err := c.query(query, &prices)
 	if err != nil {
 		return nil, err
 	}
 
-	return ConvertCoingeckoPrices(prices), nil
+	return ConvertCoingeckoPrices(prices, currency), nil
 }
 
-func ConvertCoingeckoPrices(prices []MarketTicker) []types.TokenPrice {
+// GetTokenPriceHistory queries the remote APIs to get the prices, quoted in the given currency,
+// of the token having the given id between the given times
+func (c *Client) GetTokenPriceHistory(id string, currency string, from, to time.Time) (MarketChart, error) {
+	var chart MarketChart
+	query := fmt.Sprintf("/coins/%s/market_chart/range?vs_currency=%s&from=%d&to=%d",
+		url.PathEscape(id), url.QueryEscape(currency), from.Unix(), to.Unix())
+	err := c.query(query, &chart)
+	return chart, err
+}
+
+// ConvertMarketChart converts the given chart into the prices of the unit having the given name
+func ConvertMarketChart(unitName string, currency string, chart MarketChart) []types.TokenPrice {
+	marketCaps := make(map[float64]float64, len(chart.MarketCaps))
+	for _, entry := range chart.MarketCaps {
+		if len(entry) == 2 {
+			marketCaps[entry[0]] = entry[1]
+		}
+	}
+
+	tokenPrices := make([]types.TokenPrice, 0, len(chart.Prices))
+	for _, entry := range chart.Prices {
+		if len(entry) != 2 {
+			continue
+		}
+
+		tokenPrices = append(tokenPrices, types.NewTokenPrice(
+			unitName,
+			currency,
+			entry[1],
+			int64(math.Trunc(marketCaps[entry[0]])),
+			time.UnixMilli(int64(entry[0])).UTC(),
+		))
+	}
+	return tokenPrices
+}
+
+func ConvertCoingeckoPrices(prices []MarketTicker, currency string) []types.TokenPrice {
 	tokenPrices := make([]types.TokenPrice, len(prices))
 	for i, price := range prices {
 		tokenPrices[i] = types.NewTokenPrice(
 			price.Symbol,
+			currency,
 			price.CurrentPrice,
 			int64(math.Trunc(price.MarketCap)),
 			price.LastUpdated,
@@ -42,24 +121,7 @@ func ConvertCoingeckoPrices(prices []MarketTicker) []types.TokenPrice {
 	return tokenPrices
 }
 
-// queryCoinGecko queries the CoinGecko APIs for the given endpoint
-func queryCoinGecko(endpoint string, ptr interface{}) error {
-	resp, err := http.Get("https://api.coingecko.com/api/v3" + endpoint)
-	if err != nil {
-		return err
-	}
-
-	defer resp.Body.Close()
-
-	bz, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("error while reading response body: %s", err)
-	}
-
-	err = json.Unmarshal(bz, &ptr)
-	if err != nil {
-		return fmt.Errorf("error while unmarshaling response body: %s", err)
-	}
-
-	return nil
+// query queries the CoinGecko APIs for the given endpoint
+func (c *Client) query(endpoint string, ptr interface{}) error {
+	return c.http.GetJSON(c.baseURL+endpoint, nil, ptr)
 }
@@ -111,7 +111,7 @@ func TestConvertCoingeckoPrices(t *testing.T) {
 	err := json.Unmarshal([]byte(result), &apisPrices)
 	require.NoError(t, err)
 
-	prices := coingecko.ConvertCoingeckoPrices(apisPrices)
+	prices := coingecko.ConvertCoingeckoPrices(apisPrices, "usd")
 	require.Equal(t, int64(8809250407), prices[0].MarketCap)
 	require.Equal(t, int64(0), prices[1].MarketCap)
 	require.Equal(t, int64(836648999243), prices[2].MarketCap)
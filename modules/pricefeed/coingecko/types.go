@@ -22,3 +22,10 @@ type MarketTicker struct {
 
 // MarketTickers is an array of MarketTicker
 type MarketTickers []MarketTicker
+
+// MarketChart contains the historical market data of a single token.
+// Each entry is made of a unix timestamp in milliseconds and a value.
+type MarketChart struct {
+	Prices     [][]float64 `json:"prices"`
+	MarketCaps [][]float64 `json:"market_caps"`
+}
@@ -1,14 +1,36 @@
 package pricefeed
 
 import (
+	"fmt"
+	"strings"
+
 	"gopkg.in/yaml.v3"
 
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/providers"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
+const (
+	// DefaultProvider is the price provider used by the tokens not setting one
+	DefaultProvider = providers.TypeCoinGecko
+)
+
 // Config contains the configuration about the pricefeed module
 type Config struct {
-	Tokens []types.Token `yaml:"tokens"`
+	Tokens     []types.Token                `yaml:"tokens"`
+	Currencies []string                     `yaml:"currencies,omitempty"`
+	Providers  map[string]*providers.Config `yaml:"providers,omitempty"`
+	Gold       *GoldConfig                  `yaml:"gold,omitempty"`
+}
+
+// GoldConfig contains the configuration of the gold spot price feed
+type GoldConfig struct {
+	// Provider is the name of the price provider returning the USD price of a troy ounce
+	Provider string `yaml:"provider"`
+	// PriceID is the id of the gold spot price inside the provider
+	PriceID string `yaml:"price_id"`
+	// Backed contains the grams of gold backing a single unit of each gold-backed denom
+	Backed map[string]float64 `yaml:"backed,omitempty"`
 }
 
 // NewConfig returns a new Config instance
@@ -18,11 +40,75 @@ func NewConfig(tokens []types.Token) *Config {
 	}
 }
 
+// Validate checks that every token uses a configured provider,
+// adding the default CoinGecko one and the default currency when they are not configured explicitly
+func (cfg *Config) Validate() error {
+	currencies := make([]string, 0, len(cfg.Currencies))
+	seen := make(map[string]bool, len(cfg.Currencies))
+	for _, currency := range cfg.Currencies {
+		currency = strings.ToLower(strings.TrimSpace(currency))
+		if currency == "" || seen[currency] {
+			continue
+		}
+		seen[currency] = true
+		currencies = append(currencies, currency)
+	}
+	if len(currencies) == 0 {
+		currencies = []string{types.DefaultCurrency}
+	}
+	cfg.Currencies = currencies
+
+	if cfg.Providers == nil {
+		cfg.Providers = map[string]*providers.Config{}
+	}
+
+	if _, ok := cfg.Providers[DefaultProvider]; !ok {
+		cfg.Providers[DefaultProvider] = &providers.Config{Type: providers.TypeCoinGecko}
+	}
+
+	for i, token := range cfg.Tokens {
+		if token.Provider == "" {
+			cfg.Tokens[i].Provider = DefaultProvider
+			continue
+		}
+
+		if _, ok := cfg.Providers[token.Provider]; !ok {
+			return fmt.Errorf("price provider %s of token %s is not configured", token.Provider, token.Name)
+		}
+	}
+
+	if cfg.Gold != nil {
+		if cfg.Gold.Provider == "" {
+			cfg.Gold.Provider = DefaultProvider
+		}
+
+		if _, ok := cfg.Providers[cfg.Gold.Provider]; !ok {
+			return fmt.Errorf("gold price provider %s is not configured", cfg.Gold.Provider)
+		}
+
+		if cfg.Gold.PriceID == "" {
+			return fmt.Errorf("gold price id is not set")
+		}
+
+		for denom, grams := range cfg.Gold.Backed {
+			if grams <= 0 {
+				return fmt.Errorf("invalid grams per unit for gold-backed denom %s: %v", denom, grams)
+			}
+		}
+	}
+
+	return nil
+}
+
 func ParseConfig(bz []byte) (*Config, error) {
 	type T struct {
 		Config *Config `yaml:"pricefeed"`
 	}
 	var cfg T
 	err := yaml.Unmarshal(bz, &cfg)
-	return cfg.Config, err
+	if err != nil || cfg.Config == nil {
+		return cfg.Config, err
+	}
+
+	return cfg.Config, cfg.Config.Validate()
 }
@@ -0,0 +1,79 @@
+package pricefeed
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// getGoldPrice returns the current gold spot price from the configured provider
+func (m *Module) getGoldPrice() (types.GoldPrice, error) {
+	gold := m.cfg.Gold
+	token := types.NewToken(gold.PriceID, []types.TokenUnit{
+		types.NewTokenUnit(gold.PriceID, 0, nil, gold.PriceID),
+	})
+
+	prices, err := m.providers[gold.Provider].GetTokensPrices([]types.Token{token}, types.DefaultCurrency)
+	if err != nil {
+		return types.GoldPrice{}, fmt.Errorf("error while getting gold price: %s", err)
+	}
+
+	if len(prices) == 0 {
+		return types.GoldPrice{}, fmt.Errorf("gold price %s not returned by provider %s", gold.PriceID, gold.Provider)
+	}
+
+	return types.NewGoldPrice(prices[0].Price, prices[0].Timestamp), nil
+}
+
+// computeNAVs returns the NAV of each configured gold-backed unit based on the given spot price
+func computeNAVs(backed map[string]float64, spot types.GoldPrice) []types.TokenNAV {
+	denoms := make([]string, 0, len(backed))
+	for denom := range backed {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	navs := make([]types.TokenNAV, len(denoms))
+	for i, denom := range denoms {
+		navs[i] = types.NewTokenNAV(denom, backed[denom], spot)
+	}
+	return navs
+}
+
+// updateGold fetches the gold spot price and stores it along with the NAVs of the gold-backed units,
+// either as the most updated values or as historic ones
+func (m *Module) updateGold(history bool) error {
+	if m.cfg == nil || m.cfg.Gold == nil {
+		return nil
+	}
+
+	spot, err := m.getGoldPrice()
+	if err != nil {
+		return err
+	}
+
+	if history {
+		// Align the timestamp with the one of the token prices history, as done for them
+		spot.Timestamp = time.Now()
+	}
+
+	navs := computeNAVs(m.cfg.Gold.Backed, spot)
+
+	if history {
+		err = m.db.SaveGoldPriceHistory(spot)
+		if err != nil {
+			return err
+		}
+
+		return m.db.SaveTokensNAVHistory(navs)
+	}
+
+	err = m.db.SaveGoldPrice(spot)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveTokensNAV(navs)
+}
@@ -0,0 +1,51 @@
+package pricefeed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/providers"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func TestModule_getGoldPrice(t *testing.T) {
+	m := &Module{
+		cfg: &Config{Gold: &GoldConfig{Provider: "manual", PriceID: "xau"}},
+		providers: map[string]providers.PriceProvider{
+			"manual": providers.NewStaticProvider(map[string]float64{"xau": 2000}, nil),
+		},
+	}
+
+	spot, err := m.getGoldPrice()
+	require.NoError(t, err)
+	require.Equal(t, 2000.0, spot.Price)
+}
+
+func TestComputeNAVs(t *testing.T) {
+	timestamp := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	spot := types.NewGoldPrice(types.TroyOunceGrams*60, timestamp)
+
+	navs := computeNAVs(map[string]float64{"gold": 1, "mgold": 0.001}, spot)
+	require.Len(t, navs, 2)
+
+	require.Equal(t, "gold", navs[0].UnitName)
+	require.InDelta(t, 60, navs[0].NAV, 1e-9)
+	require.Equal(t, timestamp, navs[0].Timestamp)
+
+	require.Equal(t, "mgold", navs[1].UnitName)
+	require.InDelta(t, 0.06, navs[1].NAV, 1e-9)
+}
+
+func TestConfig_ValidateGold(t *testing.T) {
+	cfg := &Config{Gold: &GoldConfig{PriceID: "tether-gold", Backed: map[string]float64{"gold": 1}}}
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, DefaultProvider, cfg.Gold.Provider)
+
+	cfg = &Config{Gold: &GoldConfig{Provider: "missing", PriceID: "xau"}}
+	require.Error(t, cfg.Validate())
+
+	cfg = &Config{Gold: &GoldConfig{PriceID: "xau", Backed: map[string]float64{"gold": 0}}}
+	require.Error(t, cfg.Validate())
+}
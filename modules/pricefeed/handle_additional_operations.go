@@ -47,7 +47,9 @@ func (m *Module) storeTokens() error {
 				continue
 			}
 
-			prices = append(prices, types.NewTokenPrice(unit.Denom, 0, 0, time.Time{}))
+			for _, currency := range m.cfg.Currencies {
+				prices = append(prices, types.NewTokenPrice(unit.Denom, currency, 0, 0, time.Time{}))
+			}
 		}
 	}
 
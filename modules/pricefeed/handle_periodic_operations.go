@@ -2,6 +2,7 @@ package pricefeed
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/go-co-op/gocron"
@@ -9,7 +10,6 @@ import (
 
 	"github.com/forbole/bdjuno/v4/types"
 
-	"github.com/forbole/bdjuno/v4/modules/pricefeed/coingecko"
 	"github.com/forbole/bdjuno/v4/modules/utils"
 )
 
@@ -31,26 +31,59 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while setting up history period operations: %s", err)
 	}
 
+	// Roll up the token price history into the candles every 10 mins
+	if _, err := scheduler.Every(10).Minutes().Do(func() {
+		utils.WatchMethod(m.RollUpCandles)
+	}); err != nil {
+		return fmt.Errorf("error while setting up candles period operations: %s", err)
+	}
+
 	return nil
 }
 
-// getTokenPrices allows to get the most up-to-date token prices
+// getTokenPrices allows to get the most up-to-date token prices, in each configured currency,
+// from the provider of each token
 func (m *Module) getTokenPrices() ([]types.TokenPrice, error) {
-	// Get the list of tokens price id
-	ids, err := m.db.GetTokensPriceID()
-	if err != nil {
-		return nil, fmt.Errorf("error while getting tokens price id: %s", err)
+	if m.cfg == nil {
+		return nil, nil
 	}
 
-	if len(ids) == 0 {
-		log.Debug().Str("module", "pricefeed").Msg("no traded tokens price id found")
-		return nil, nil
+	// Group the tokens by their price provider
+	tokens := make(map[string][]types.Token)
+	for _, token := range m.cfg.Tokens {
+		tokens[token.Provider] = append(tokens[token.Provider], token)
 	}
 
-	// Get the tokens prices
-	prices, err := coingecko.GetTokensPrices(ids)
-	if err != nil {
-		return nil, fmt.Errorf("error while getting tokens prices: %s", err)
+	names := make([]string, 0, len(tokens))
+	for name := range tokens {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var prices []types.TokenPrice
+	var requests, failed int
+	for _, currency := range m.cfg.Currencies {
+		for _, name := range names {
+			requests++
+			providerPrices, err := m.providers[name].GetTokensPrices(tokens[name], currency)
+			if err != nil {
+				// Do not let a single failing provider prevent the other prices from being updated
+				log.Error().Str("module", "pricefeed").Str("provider", name).Str("currency", currency).Err(err).
+					Msg("error while getting tokens prices")
+				failed++
+				continue
+			}
+
+			prices = append(prices, providerPrices...)
+		}
+	}
+
+	if failed > 0 && failed == requests {
+		return nil, fmt.Errorf("error while getting tokens prices: all the price providers failed")
+	}
+
+	if len(prices) == 0 {
+		log.Debug().Str("module", "pricefeed").Msg("no traded tokens price found")
 	}
 
 	return prices, nil
@@ -74,6 +107,18 @@ func (m *Module) UpdatePrice() error {
 		return fmt.Errorf("error while saving token prices: %s", err)
 	}
 
+	// Merge the prices into the current candles, so that they reflect all the samples
+	// and not only the historic prices rolled up every hour
+	err = m.saveCandles(prices)
+	if err != nil {
+		return fmt.Errorf("error while saving token price candles: %s", err)
+	}
+
+	err = m.updateGold(false)
+	if err != nil {
+		return fmt.Errorf("error while updating gold price: %s", err)
+	}
+
 	return nil
 
 }
@@ -105,5 +150,10 @@ func (m *Module) UpdatePricesHistory() error {
 		return fmt.Errorf("error while saving token prices history: %s", err)
 	}
 
+	err = m.updateGold(true)
+	if err != nil {
+		return fmt.Errorf("error while updating gold price history: %s", err)
+	}
+
 	return nil
 }
@@ -1,10 +1,13 @@
 package pricefeed
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/forbole/juno/v5/types/config"
 
 	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/providers"
 
 	"github.com/forbole/juno/v5/modules"
 )
@@ -17,9 +20,10 @@ var (
 
 // Module represents the module that allows to get the token prices
 type Module struct {
-	cfg *Config
-	cdc codec.Codec
-	db  *database.Db
+	cfg       *Config
+	cdc       codec.Codec
+	db        *database.Db
+	providers map[string]providers.PriceProvider
 }
 
 // NewModule returns a new Module instance
@@ -34,13 +38,37 @@ func NewModule(cfg config.Config, cdc codec.Codec, db *database.Db) *Module {
 		panic(err)
 	}
 
+	priceProviders, err := buildProviders(pricefeedCfg, db)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
-		cfg: pricefeedCfg,
-		cdc: cdc,
-		db:  db,
+		cfg:       pricefeedCfg,
+		cdc:       cdc,
+		db:        db,
+		providers: priceProviders,
 	}
 }
 
+// buildProviders builds the price providers defined inside the given configuration
+func buildProviders(cfg *Config, db *database.Db) (map[string]providers.PriceProvider, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	priceProviders := make(map[string]providers.PriceProvider, len(cfg.Providers))
+	for name, providerCfg := range cfg.Providers {
+		provider, err := providers.Build(name, providerCfg, db)
+		if err != nil {
+			return nil, fmt.Errorf("error while building price provider %s: %s", name, err)
+		}
+		priceProviders[name] = provider
+	}
+
+	return priceProviders, nil
+}
+
 // Name implements modules.Module
 func (m *Module) Name() string {
 	return "pricefeed"
@@ -0,0 +1,52 @@
+package providers
+
+import (
+	"time"
+
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/coingecko"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+var (
+	_ HistoryProvider = &CoinGeckoProvider{}
+)
+
+// CoinGeckoProvider represents a PriceProvider that reads the prices from the CoinGecko APIs.
+// Price ids are the CoinGecko coin ids, and prices are stored using the coin symbol as unit name.
+type CoinGeckoProvider struct {
+	client *coingecko.Client
+}
+
+// NewCoinGeckoProvider returns a new CoinGeckoProvider querying the APIs at the given URL,
+// or the public ones if the URL is empty, using the given HTTP client
+func NewCoinGeckoProvider(baseURL string, client *httpclient.Client) *CoinGeckoProvider {
+	return &CoinGeckoProvider{
+		client: coingecko.NewClient(baseURL, client),
+	}
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *CoinGeckoProvider) GetTokensPrices(tokens []types.Token, currency string) ([]types.TokenPrice, error) {
+	units := priceUnits(tokens)
+	if len(units) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(units))
+	for i, unit := range units {
+		ids[i] = unit.PriceID
+	}
+
+	return p.client.GetTokensPrices(ids, currency)
+}
+
+// GetTokenPriceHistory implements HistoryProvider
+func (p *CoinGeckoProvider) GetTokenPriceHistory(unit types.TokenUnit, currency string, from, to time.Time) ([]types.TokenPrice, error) {
+	chart, err := p.client.GetTokenPriceHistory(unit.PriceID, currency, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return coingecko.ConvertMarketChart(unit.Denom, currency, chart), nil
+}
@@ -0,0 +1,80 @@
+package providers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/providers"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// testClient returns an HTTP client that does not retry the failed requests
+func testClient() *httpclient.Client {
+	return httpclient.NewClient("test", &httpclient.Config{MaxRetries: -1})
+}
+
+func TestCoinGeckoProvider_GetTokensPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/coins/markets", r.URL.Path)
+		require.Equal(t, "eur", r.URL.Query().Get("vs_currency"))
+		require.Equal(t, "cosmos", r.URL.Query().Get("ids"))
+
+		w.Write([]byte(`[{"id":"cosmos","symbol":"atom","current_price":31.16,"market_cap":8809250407.5,"last_updated":"2021-09-13T08:48:15.930Z"}]`))
+	}))
+	defer server.Close()
+
+	provider := providers.NewCoinGeckoProvider(server.URL, testClient())
+	prices, err := provider.GetTokensPrices([]types.Token{
+		types.NewToken("atom", []types.TokenUnit{
+			types.NewTokenUnit("uatom", 0, nil, ""),
+			types.NewTokenUnit("atom", 6, nil, "cosmos"),
+		}),
+	}, "eur")
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, "atom", prices[0].UnitName)
+	require.Equal(t, "eur", prices[0].Currency)
+	require.Equal(t, 31.16, prices[0].Price)
+	require.Equal(t, int64(8809250407), prices[0].MarketCap)
+}
+
+func TestCoinGeckoProvider_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	provider := providers.NewCoinGeckoProvider(server.URL, testClient())
+	_, err := provider.GetTokensPrices([]types.Token{
+		types.NewToken("atom", []types.TokenUnit{types.NewTokenUnit("atom", 6, nil, "cosmos")}),
+	}, "usd")
+	require.Error(t, err)
+}
+
+func TestCoinGeckoProvider_GetTokenPriceHistory(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/coins/cosmos/market_chart/range", r.URL.Path)
+		require.Equal(t, "usd", r.URL.Query().Get("vs_currency"))
+		require.Equal(t, "1672531200", r.URL.Query().Get("from"))
+		require.Equal(t, "1672538400", r.URL.Query().Get("to"))
+
+		w.Write([]byte(`{"prices":[[1672531200000,10.5],[1672534800000,11]],"market_caps":[[1672531200000,1000.7],[1672534800000,1100]]}`))
+	}))
+	defer server.Close()
+
+	provider := providers.NewCoinGeckoProvider(server.URL, testClient())
+	prices, err := provider.GetTokenPriceHistory(types.NewTokenUnit("atom", 6, nil, "cosmos"), "usd", from, to)
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenPrice{
+		types.NewTokenPrice("atom", "usd", 10.5, 1000, from),
+		types.NewTokenPrice("atom", "usd", 11, 1100, from.Add(time.Hour)),
+	}, prices)
+}
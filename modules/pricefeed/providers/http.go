@@ -0,0 +1,197 @@
+package providers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	// PriceIDPlaceholder is replaced with the unit price id inside the http provider URL
+	PriceIDPlaceholder = "{id}"
+
+	// CurrencyPlaceholder is replaced with the quote currency inside the http provider URL
+	CurrencyPlaceholder = "{currency}"
+)
+
+var (
+	_ PriceProvider = &HTTPProvider{}
+)
+
+// FieldsConfig contains the dotted paths of the response fields holding each value.
+// Array elements are selected using their index, e.g. "data.0.price".
+type FieldsConfig struct {
+	Price     string `yaml:"price"`
+	MarketCap string `yaml:"market_cap,omitempty"`
+	Timestamp string `yaml:"timestamp,omitempty"`
+}
+
+// HTTPProvider represents a PriceProvider that reads the price of each unit from a generic JSON API
+type HTTPProvider struct {
+	url     string
+	headers map[string]string
+	fields  FieldsConfig
+	http    *httpclient.Client
+}
+
+// NewHTTPProvider returns a new HTTPProvider querying the given URL, where the {id} and {currency}
+// placeholders are replaced with the unit price id and the quote currency,
+// and reading the values from the given response fields
+func NewHTTPProvider(url string, headers map[string]string, fields FieldsConfig, client *httpclient.Client) (*HTTPProvider, error) {
+	if url == "" {
+		return nil, fmt.Errorf("http price provider url is not set")
+	}
+
+	if fields.Price == "" {
+		return nil, fmt.Errorf("http price provider price field is not set")
+	}
+
+	return &HTTPProvider{
+		url:     url,
+		headers: headers,
+		fields:  fields,
+		http:    client,
+	}, nil
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *HTTPProvider) GetTokensPrices(tokens []types.Token, currency string) ([]types.TokenPrice, error) {
+	var prices []types.TokenPrice
+	for _, unit := range priceUnits(tokens) {
+		price, err := p.getPrice(unit, currency)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting price of %s: %s", unit.PriceID, err)
+		}
+
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
+
+// getPrice queries the API for the price of the given unit quoted in the given currency
+func (p *HTTPProvider) getPrice(unit types.TokenUnit, currency string) (types.TokenPrice, error) {
+	endpoint := strings.NewReplacer(
+		PriceIDPlaceholder, url.PathEscape(unit.PriceID),
+		CurrencyPlaceholder, url.PathEscape(currency),
+	).Replace(p.url)
+
+	bz, err := p.http.Get(endpoint, p.headers)
+	if err != nil {
+		return types.TokenPrice{}, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var body interface{}
+	err = decoder.Decode(&body)
+	if err != nil {
+		return types.TokenPrice{}, fmt.Errorf("error while unmarshaling response body: %s", err)
+	}
+
+	price, err := numberField(body, p.fields.Price)
+	if err != nil {
+		return types.TokenPrice{}, err
+	}
+
+	var marketCap float64
+	if p.fields.MarketCap != "" {
+		marketCap, err = numberField(body, p.fields.MarketCap)
+		if err != nil {
+			return types.TokenPrice{}, err
+		}
+	}
+
+	timestamp := time.Now()
+	if p.fields.Timestamp != "" {
+		timestamp, err = timeField(body, p.fields.Timestamp)
+		if err != nil {
+			return types.TokenPrice{}, err
+		}
+	}
+
+	return types.NewTokenPrice(unit.Denom, currency, price, int64(math.Trunc(marketCap)), timestamp), nil
+}
+
+// lookupField returns the value found at the given dotted path inside the given JSON body
+func lookupField(body interface{}, path string) (interface{}, error) {
+	value := body
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			field, ok := v[key]
+			if !ok {
+				return nil, fmt.Errorf("field %s not found in response", path)
+			}
+			value = field
+
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, fmt.Errorf("field %s not found in response", path)
+			}
+			value = v[index]
+
+		default:
+			return nil, fmt.Errorf("field %s not found in response", path)
+		}
+	}
+
+	return value, nil
+}
+
+// numberField returns the number found at the given path, which can be either a JSON number or a string
+func numberField(body interface{}, path string) (float64, error) {
+	value, err := lookupField(body, path)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number in field %s: %s", path, err)
+		}
+		return number, nil
+	default:
+		return 0, fmt.Errorf("field %s is not a number", path)
+	}
+}
+
+// timeField returns the time found at the given path, which can be either an RFC 3339 string or
+// a unix timestamp in seconds
+func timeField(body interface{}, path string) (time.Time, error) {
+	value, err := lookupField(body, path)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		seconds, err := v.Float64()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp in field %s: %s", path, err)
+		}
+		return time.Unix(0, int64(seconds*float64(time.Second))).UTC(), nil
+	case string:
+		timestamp, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp in field %s: %s", path, err)
+		}
+		return timestamp, nil
+	default:
+		return time.Time{}, fmt.Errorf("field %s is not a timestamp", path)
+	}
+}
@@ -0,0 +1,65 @@
+package providers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/providers"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func TestHTTPProvider_GetTokensPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/tickers/ovg/uah", r.URL.Path)
+		require.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+
+		w.Write([]byte(`{"data":[{"last":"1.25","cap":1000000.9,"time":1700000000}]}`))
+	}))
+	defer server.Close()
+
+	provider, err := providers.NewHTTPProvider(
+		server.URL+"/tickers/{id}/{currency}",
+		map[string]string{"X-Api-Key": "secret"},
+		providers.FieldsConfig{Price: "data.0.last", MarketCap: "data.0.cap", Timestamp: "data.0.time"},
+		testClient(),
+	)
+	require.NoError(t, err)
+
+	prices, err := provider.GetTokensPrices([]types.Token{
+		types.NewToken("ovg", []types.TokenUnit{
+			types.NewTokenUnit("uovg", 0, nil, ""),
+			types.NewTokenUnit("ovg", 8, nil, "ovg"),
+		}),
+	}, "uah")
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenPrice{
+		types.NewTokenPrice("ovg", "uah", 1.25, 1000000, time.Unix(1700000000, 0).UTC()),
+	}, prices)
+}
+
+func TestHTTPProvider_MissingField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	provider, err := providers.NewHTTPProvider(server.URL+"/{id}", nil, providers.FieldsConfig{Price: "data.0.last"}, testClient())
+	require.NoError(t, err)
+
+	_, err = provider.GetTokensPrices([]types.Token{
+		types.NewToken("ovg", []types.TokenUnit{types.NewTokenUnit("ovg", 8, nil, "ovg")}),
+	}, "usd")
+	require.Error(t, err)
+}
+
+func TestNewHTTPProvider_InvalidConfig(t *testing.T) {
+	_, err := providers.NewHTTPProvider("", nil, providers.FieldsConfig{Price: "price"}, testClient())
+	require.Error(t, err)
+
+	_, err = providers.NewHTTPProvider("http://localhost/{id}", nil, providers.FieldsConfig{}, testClient())
+	require.Error(t, err)
+}
@@ -0,0 +1,118 @@
+package providers
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+var (
+	_ PriceProvider = &OnChainProvider{}
+)
+
+// ChainSource contains the indexed chain data used to derive the prices
+type ChainSource interface {
+	GetTokenPrice(unitName string, currency string) (*types.TokenPrice, error)
+	GetSupply() (sdk.Coins, error)
+}
+
+// DerivedPrice tells how the price of a unit is derived from the one of another unit
+type DerivedPrice struct {
+	// From is the name of the unit whose stored price is used
+	From string `yaml:"from"`
+	// Ratio is the amount of From units a single unit is worth, defaults to 1
+	Ratio float64 `yaml:"ratio,omitempty"`
+}
+
+// OnChainProvider represents a PriceProvider deriving the prices from the indexed chain data.
+// The price of each unit is the latest stored price of another unit, in the same currency, times a fixed ratio,
+// while the market cap is computed from the indexed total supply.
+type OnChainProvider struct {
+	derived map[string]DerivedPrice
+	source  ChainSource
+}
+
+// NewOnChainProvider returns a new OnChainProvider deriving the price of each price id as configured
+func NewOnChainProvider(derived map[string]DerivedPrice, source ChainSource) (*OnChainProvider, error) {
+	if source == nil {
+		return nil, fmt.Errorf("onchain price provider requires the chain data source")
+	}
+
+	for id, derivation := range derived {
+		if derivation.From == "" {
+			return nil, fmt.Errorf("onchain price provider source unit not set for price id %s", id)
+		}
+	}
+
+	return &OnChainProvider{
+		derived: derived,
+		source:  source,
+	}, nil
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *OnChainProvider) GetTokensPrices(tokens []types.Token, currency string) ([]types.TokenPrice, error) {
+	supply, err := p.source.GetSupply()
+	if err != nil {
+		return nil, err
+	}
+
+	var prices []types.TokenPrice
+	for _, token := range tokens {
+		for _, unit := range token.Units {
+			if unit.PriceID == "" {
+				continue
+			}
+
+			derivation, ok := p.derived[unit.PriceID]
+			if !ok {
+				return nil, fmt.Errorf("no derivation set for price id %s", unit.PriceID)
+			}
+
+			reference, err := p.source.GetTokenPrice(derivation.From, currency)
+			if err != nil {
+				return nil, err
+			}
+
+			if reference == nil {
+				return nil, fmt.Errorf("no %s price stored for unit %s", currency, derivation.From)
+			}
+
+			ratio := derivation.Ratio
+			if ratio == 0 {
+				ratio = 1
+			}
+
+			price := reference.Price * ratio
+			marketCap := price * circulatingAmount(supply, token, unit)
+
+			prices = append(prices, types.NewTokenPrice(unit.Denom, currency, price, int64(math.Trunc(marketCap)), reference.Timestamp))
+		}
+	}
+
+	return prices, nil
+}
+
+// circulatingAmount returns the total supply of the given token expressed in the given unit
+func circulatingAmount(supply sdk.Coins, token types.Token, unit types.TokenUnit) float64 {
+	for _, base := range token.Units {
+		if base.Exponent != 0 {
+			continue
+		}
+
+		for _, coin := range supply {
+			if coin.Denom != base.Denom || coin.Amount.IsNil() {
+				continue
+			}
+
+			value, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+			return value / math.Pow10(unit.Exponent)
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,91 @@
+package providers_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/pricefeed/providers"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+type mockChainSource struct {
+	prices map[string]types.TokenPrice
+	supply sdk.Coins
+}
+
+func (s mockChainSource) GetTokenPrice(unitName string, currency string) (*types.TokenPrice, error) {
+	price, ok := s.prices[unitName+"/"+currency]
+	if !ok {
+		return nil, nil
+	}
+	return &price, nil
+}
+
+func (s mockChainSource) GetSupply() (sdk.Coins, error) {
+	return s.supply, nil
+}
+
+func TestOnChainProvider_GetTokensPrices(t *testing.T) {
+	timestamp := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := mockChainSource{
+		prices: map[string]types.TokenPrice{
+			"ovg/usd": types.NewTokenPrice("ovg", "usd", 2, 0, timestamp),
+		},
+		supply: sdk.NewCoins(sdk.NewInt64Coin("ustovg", 5_000_000)),
+	}
+
+	provider, err := providers.NewOnChainProvider(map[string]providers.DerivedPrice{
+		"stovg": {From: "ovg", Ratio: 1.5},
+	}, source)
+	require.NoError(t, err)
+
+	prices, err := provider.GetTokensPrices([]types.Token{
+		types.NewToken("stovg", []types.TokenUnit{
+			types.NewTokenUnit("ustovg", 0, nil, ""),
+			types.NewTokenUnit("stovg", 6, nil, "stovg"),
+		}),
+	}, "usd")
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenPrice{
+		types.NewTokenPrice("stovg", "usd", 3, 15, timestamp),
+	}, prices)
+}
+
+func TestOnChainProvider_MissingReferencePrice(t *testing.T) {
+	provider, err := providers.NewOnChainProvider(map[string]providers.DerivedPrice{
+		"stovg": {From: "ovg"},
+	}, mockChainSource{})
+	require.NoError(t, err)
+
+	_, err = provider.GetTokensPrices([]types.Token{
+		types.NewToken("stovg", []types.TokenUnit{types.NewTokenUnit("stovg", 6, nil, "stovg")}),
+	}, "usd")
+	require.Error(t, err)
+}
+
+func TestStaticProvider_GetTokensPrices(t *testing.T) {
+	provider := providers.NewStaticProvider(map[string]float64{"gold": 65.5}, map[string]map[string]float64{"eur": {"gold": 60}})
+
+	prices, err := provider.GetTokensPrices([]types.Token{
+		types.NewToken("gold", []types.TokenUnit{types.NewTokenUnit("gold", 6, nil, "gold")}),
+	}, "usd")
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, "gold", prices[0].UnitName)
+	require.Equal(t, 65.5, prices[0].Price)
+
+	prices, err = provider.GetTokensPrices([]types.Token{
+		types.NewToken("gold", []types.TokenUnit{types.NewTokenUnit("gold", 6, nil, "gold")}),
+	}, "eur")
+	require.NoError(t, err)
+	require.Equal(t, 60.0, prices[0].Price)
+	require.Equal(t, "eur", prices[0].Currency)
+
+	_, err = provider.GetTokensPrices([]types.Token{
+		types.NewToken("ovg", []types.TokenUnit{types.NewTokenUnit("ovg", 8, nil, "ovg")}),
+	}, "usd")
+	require.Error(t, err)
+}
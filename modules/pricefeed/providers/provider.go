@@ -0,0 +1,87 @@
+package providers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	TypeCoinGecko = "coingecko"
+	TypeHTTP      = "http"
+	TypeStatic    = "static"
+	TypeOnChain   = "onchain"
+)
+
+// PriceProvider represents a source of token prices
+type PriceProvider interface {
+	// GetTokensPrices returns the current prices, quoted in the given currency,
+	// of the units of the given tokens having a price id
+	GetTokensPrices(tokens []types.Token, currency string) ([]types.TokenPrice, error)
+}
+
+// HistoryProvider represents a PriceProvider that is also able to return past prices
+type HistoryProvider interface {
+	PriceProvider
+
+	// GetTokenPriceHistory returns the prices, quoted in the given currency, of the given unit between the given times
+	GetTokenPriceHistory(unit types.TokenUnit, currency string, from, to time.Time) ([]types.TokenPrice, error)
+}
+
+// Config contains the configuration of a single price provider.
+// Only the fields related to the provider type are used.
+type Config struct {
+	Type string `yaml:"type"`
+
+	// BaseURL is the URL of the CoinGecko APIs, used by the coingecko provider
+	BaseURL string `yaml:"base_url,omitempty"`
+
+	// URL, Headers and Fields are used by the http provider
+	URL     string            `yaml:"url,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Fields  FieldsConfig      `yaml:"fields,omitempty"`
+
+	// Prices contains the USD price of each price id, while QuotePrices contains the ones quoted
+	// in the other currencies; both are used by the static provider
+	Prices      map[string]float64            `yaml:"prices,omitempty"`
+	QuotePrices map[string]map[string]float64 `yaml:"quote_prices,omitempty"`
+
+	// Derived contains how the price of each price id is computed, used by the onchain provider
+	Derived map[string]DerivedPrice `yaml:"derived,omitempty"`
+}
+
+// Build returns the PriceProvider having the given name and described by the given configuration.
+// Remote providers use the shared HTTP client of the upstream having the provider name.
+func Build(name string, cfg *Config, source ChainSource) (PriceProvider, error) {
+	switch cfg.Type {
+	case TypeCoinGecko:
+		return NewCoinGeckoProvider(cfg.BaseURL, httpclient.Upstream(name)), nil
+
+	case TypeHTTP:
+		return NewHTTPProvider(cfg.URL, cfg.Headers, cfg.Fields, httpclient.Upstream(name))
+
+	case TypeStatic:
+		return NewStaticProvider(cfg.Prices, cfg.QuotePrices), nil
+
+	case TypeOnChain:
+		return NewOnChainProvider(cfg.Derived, source)
+
+	default:
+		return nil, fmt.Errorf("invalid price provider type: %s", cfg.Type)
+	}
+}
+
+// priceUnits returns the units of the given tokens having a price id
+func priceUnits(tokens []types.Token) []types.TokenUnit {
+	var units []types.TokenUnit
+	for _, token := range tokens {
+		for _, unit := range token.Units {
+			if unit.PriceID != "" {
+				units = append(units, unit)
+			}
+		}
+	}
+	return units
+}
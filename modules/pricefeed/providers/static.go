@@ -0,0 +1,50 @@
+package providers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+var (
+	_ PriceProvider = &StaticProvider{}
+)
+
+// StaticProvider represents a PriceProvider returning manually configured prices
+type StaticProvider struct {
+	prices map[string]map[string]float64
+}
+
+// NewStaticProvider returns a new StaticProvider returning the given USD price for each price id,
+// and the given quote prices for the other currencies
+func NewStaticProvider(prices map[string]float64, quotePrices map[string]map[string]float64) *StaticProvider {
+	allPrices := make(map[string]map[string]float64, len(quotePrices)+1)
+	for currency, currencyPrices := range quotePrices {
+		allPrices[currency] = currencyPrices
+	}
+	if prices != nil {
+		allPrices[types.DefaultCurrency] = prices
+	}
+
+	return &StaticProvider{
+		prices: allPrices,
+	}
+}
+
+// GetTokensPrices implements PriceProvider
+func (p *StaticProvider) GetTokensPrices(tokens []types.Token, currency string) ([]types.TokenPrice, error) {
+	timestamp := time.Now()
+
+	var prices []types.TokenPrice
+	for _, unit := range priceUnits(tokens) {
+		price, ok := p.prices[currency][unit.PriceID]
+		if !ok {
+			return nil, fmt.Errorf("no static %s price set for price id %s", currency, unit.PriceID)
+		}
+
+		prices = append(prices, types.NewTokenPrice(unit.Denom, currency, price, 0, timestamp))
+	}
+
+	return prices, nil
+}
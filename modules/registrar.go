@@ -1,10 +1,13 @@
 package modules
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/forbole/bdjuno/v4/modules/actions"
+	"github.com/forbole/bdjuno/v4/modules/nodepool"
 	"github.com/forbole/bdjuno/v4/modules/overgold"
 	"github.com/forbole/bdjuno/v4/modules/types"
 
@@ -16,6 +19,7 @@ import (
 	jmodules "github.com/forbole/juno/v5/modules"
 	"github.com/forbole/juno/v5/modules/messages"
 	"github.com/forbole/juno/v5/modules/registrar"
+	"github.com/forbole/juno/v5/node"
 
 	"github.com/forbole/bdjuno/v4/utils"
 
@@ -70,27 +74,27 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 	cdc := ctx.EncodingConfig.Codec
 	db := database.Cast(ctx.Database)
 
-	sources, err := types.BuildSources(ctx.JunoConfig.Node, ctx.EncodingConfig)
+	sources, proxy, err := buildSources(ctx)
 	if err != nil {
 		panic(err)
 	}
 
-	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig)
+	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig, db)
 	authModule := auth.NewModule(r.parser, cdc, db)
 	bankModule := bank.NewModule(r.parser, sources.BankSource, cdc, db)
 	consensusModule := consensus.NewModule(db)
-	dailyRefetchModule := dailyrefetch.NewModule(ctx.Proxy, db)
+	dailyRefetchModule := dailyrefetch.NewModule(ctx.JunoConfig, ctx.Proxy, db)
 	distrModule := distribution.NewModule(sources.DistrSource, cdc, db)
 	feegrantModule := feegrant.NewModule(cdc, db)
 	mintModule := mint.NewModule(sources.MintSource, cdc, db)
 	slashingModule := slashing.NewModule(sources.SlashingSource, cdc, db)
-	stakingModule := staking.NewModule(sources.StakingSource, cdc, db)
+	stakingModule := staking.NewModule(ctx.JunoConfig, sources.StakingSource, cdc, db)
 	govModule := gov.NewModule(sources.GovSource, distrModule, mintModule, slashingModule, stakingModule, cdc, db)
 	upgradeModule := upgrade.NewModule(db, stakingModule)
 	overgoldModules := overgold.NewModule(
 		cdc,
 		db,
-		ctx.Proxy,
+		proxy,
 		ctx.Logger,
 
 		sources.OverGoldAllowedSource,
@@ -124,3 +128,29 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 		overgoldModules,
 	}
 }
+
+// buildSources builds the sources and the node used by the custom modules.
+// When a node pool is configured, both of them send each request to one of the pool nodes.
+func buildSources(ctx registrar.Context) (*types.Sources, node.Node, error) {
+	bz, err := ctx.JunoConfig.GetBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	poolCfg, err := nodepool.ParseConfig(bz)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while parsing node pool config: %s", err)
+	}
+
+	if poolCfg == nil {
+		sources, err := types.BuildSources(ctx.JunoConfig.Node, ctx.EncodingConfig)
+		return sources, ctx.Proxy, err
+	}
+
+	pool, err := nodepool.NewPool(poolCfg, ctx.EncodingConfig.Codec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while building node pool: %s", err)
+	}
+
+	return types.BuildPoolSources(pool), pool, nil
+}
@@ -0,0 +1,24 @@
+package avatar
+
+import (
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/bdjuno/v4/modules/staking/keybase"
+)
+
+var (
+	_ Resolver = &KeybaseResolver{}
+)
+
+// KeybaseResolver represents a Resolver reading the avatar of the Keybase user having the validator identity
+type KeybaseResolver struct{}
+
+// NewKeybaseResolver returns a new KeybaseResolver instance
+func NewKeybaseResolver() *KeybaseResolver {
+	return &KeybaseResolver{}
+}
+
+// GetAvatarURL implements Resolver
+func (r *KeybaseResolver) GetAvatarURL(_ string, description stakingtypes.Description) (string, error) {
+	return keybase.GetAvatarURL(description.Identity)
+}
@@ -0,0 +1,89 @@
+package avatar
+
+import (
+	"fmt"
+	"strings"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	ResolverKeybase = "keybase"
+	ResolverStatic  = "static"
+	ResolverWebsite = "website"
+)
+
+// Resolver represents a source of validator avatars
+type Resolver interface {
+	// GetAvatarURL returns the avatar URL of the validator having the given operator address and description.
+	// If no avatar is found, it returns an empty string instead.
+	GetAvatarURL(operatorAddress string, description stakingtypes.Description) (string, error)
+}
+
+// namedResolver represents a Resolver along with the name it was configured with
+type namedResolver struct {
+	name     string
+	resolver Resolver
+}
+
+// Chain represents a list of resolvers queried in order until one of them returns an avatar
+type Chain struct {
+	resolvers []namedResolver
+}
+
+// NewChain returns a new Chain made of the resolvers having the given names
+func NewChain(names []string, staticFile string) (*Chain, error) {
+	chain := &Chain{}
+	for _, name := range names {
+		var resolver Resolver
+		switch name {
+		case ResolverKeybase:
+			resolver = NewKeybaseResolver()
+
+		case ResolverStatic:
+			static, err := NewStaticResolverFromFile(staticFile)
+			if err != nil {
+				return nil, err
+			}
+			resolver = static
+
+		case ResolverWebsite:
+			resolver = NewWebsiteResolver(nil)
+
+		default:
+			return nil, fmt.Errorf("invalid avatar resolver: %s", name)
+		}
+
+		chain.resolvers = append(chain.resolvers, namedResolver{name: name, resolver: resolver})
+	}
+
+	return chain, nil
+}
+
+// GetAvatarURL returns the first avatar URL found for the given validator, along with the name of the resolver
+// that found it. Resolvers failing are skipped, so that an unreachable source does not hide the other ones.
+// An empty URL is returned when no resolver finds an avatar, unless some of them failed: the missing avatar
+// might then be due to the failure, so an error is returned instead to let the caller keep the previous one.
+func (c *Chain) GetAvatarURL(operatorAddress string, description stakingtypes.Description) (string, string, error) {
+	var failed []string
+	for _, r := range c.resolvers {
+		url, err := r.resolver.GetAvatarURL(operatorAddress, description)
+		if err != nil {
+			log.Debug().Str("module", "staking").Str("resolver", r.name).Str("validator", operatorAddress).
+				Err(err).Msg("error while resolving validator avatar")
+			failed = append(failed, fmt.Sprintf("%s: %s", r.name, err))
+			continue
+		}
+
+		if url != "" {
+			return url, r.name, nil
+		}
+	}
+
+	if len(failed) > 0 {
+		return "", "", fmt.Errorf("no avatar found and some resolvers failed: %s", strings.Join(failed, "; "))
+	}
+
+	return "", "", nil
+}
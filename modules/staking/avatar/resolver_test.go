@@ -0,0 +1,63 @@
+package avatar
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+type failingResolver struct{}
+
+func (failingResolver) GetAvatarURL(string, stakingtypes.Description) (string, error) {
+	return "", fmt.Errorf("upstream unavailable")
+}
+
+func TestChain_GetAvatarURL(t *testing.T) {
+	chain := &Chain{resolvers: []namedResolver{
+		{name: ResolverKeybase, resolver: failingResolver{}},
+		{name: ResolverStatic, resolver: NewStaticResolver(map[string]string{"5A0D2E3C": "https://example.com/a.png"})},
+	}}
+
+	avatarURL, source, err := chain.GetAvatarURL("ovgvaloper1", stakingtypes.Description{Identity: "5A0D2E3C"})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/a.png", avatarURL)
+	require.Equal(t, ResolverStatic, source)
+
+	// The failing resolver might have found the avatar, so the result is not reliable
+	_, _, err = chain.GetAvatarURL("ovgvaloper2", stakingtypes.Description{})
+	require.Error(t, err)
+
+	chain = &Chain{resolvers: []namedResolver{
+		{name: ResolverStatic, resolver: NewStaticResolver(map[string]string{})},
+	}}
+
+	avatarURL, source, err = chain.GetAvatarURL("ovgvaloper2", stakingtypes.Description{})
+	require.NoError(t, err)
+	require.Empty(t, avatarURL)
+	require.Empty(t, source)
+}
+
+func TestNewStaticResolverFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "avatars.yaml")
+	err := os.WriteFile(path, []byte(`ovgvaloper1: https://example.com/1.png`), 0600)
+	require.NoError(t, err)
+
+	resolver, err := NewStaticResolverFromFile(path)
+	require.NoError(t, err)
+
+	avatarURL, err := resolver.GetAvatarURL("ovgvaloper1", stakingtypes.Description{})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/1.png", avatarURL)
+
+	_, err = NewStaticResolverFromFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestNewChain_InvalidResolver(t *testing.T) {
+	_, err := NewChain([]string{"gravatar"}, "")
+	require.Error(t, err)
+}
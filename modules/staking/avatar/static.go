@@ -0,0 +1,62 @@
+package avatar
+
+import (
+	"fmt"
+	"os"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ Resolver = &StaticResolver{}
+)
+
+// StaticResolver represents a Resolver returning manually configured avatars
+type StaticResolver struct {
+	avatars map[string]string
+}
+
+// NewStaticResolver returns a new StaticResolver returning the given avatars,
+// identified by either the validator operator address or its identity
+func NewStaticResolver(avatars map[string]string) *StaticResolver {
+	return &StaticResolver{
+		avatars: avatars,
+	}
+}
+
+// NewStaticResolverFromFile returns a new StaticResolver returning the avatars contained inside
+// the given YAML (or JSON) file, mapping each operator address or identity to its avatar URL
+func NewStaticResolverFromFile(path string) (*StaticResolver, error) {
+	if path == "" {
+		return nil, fmt.Errorf("static avatars file is not set")
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading static avatars file: %s", err)
+	}
+
+	var avatars map[string]string
+	err = yaml.Unmarshal(bz, &avatars)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing static avatars file: %s", err)
+	}
+
+	return NewStaticResolver(avatars), nil
+}
+
+// GetAvatarURL implements Resolver
+func (r *StaticResolver) GetAvatarURL(operatorAddress string, description stakingtypes.Description) (string, error) {
+	if url, ok := r.avatars[operatorAddress]; ok {
+		return url, nil
+	}
+
+	if description.Identity != "" {
+		if url, ok := r.avatars[description.Identity]; ok {
+			return url, nil
+		}
+	}
+
+	return "", nil
+}
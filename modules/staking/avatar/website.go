@@ -0,0 +1,138 @@
+package avatar
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
+)
+
+const (
+	// WebsiteUpstream represents the name of the validator websites upstream inside the http_clients configuration
+	WebsiteUpstream = "validator_website"
+)
+
+var (
+	_ Resolver = &WebsiteResolver{}
+
+	tagRegExp       = regexp.MustCompile(`(?is)<(?:meta|link)\s[^>]*>`)
+	attributeRegExp = regexp.MustCompile(`(?is)([a-z][a-z0-9:_-]*)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+)
+
+// WebsiteResolver represents a Resolver reading the avatar from the validator website page,
+// using its Open Graph image or its icons
+type WebsiteResolver struct {
+	http *httpclient.Client
+}
+
+// NewWebsiteResolver returns a new WebsiteResolver using the given HTTP client,
+// or the shared validator websites one if nil.
+// Since the websites are set by the validators, the client is restricted to the public addresses.
+func NewWebsiteResolver(client *httpclient.Client) *WebsiteResolver {
+	if client == nil {
+		client = httpclient.Upstream(WebsiteUpstream)
+	}
+
+	return &WebsiteResolver{
+		http: client.RestrictToPublicAddresses(),
+	}
+}
+
+// GetAvatarURL implements Resolver
+func (r *WebsiteResolver) GetAvatarURL(_ string, description stakingtypes.Description) (string, error) {
+	website := strings.TrimSpace(description.Website)
+	if website == "" || website == stakingtypes.DoNotModifyDesc {
+		return "", nil
+	}
+
+	if !strings.Contains(website, "://") {
+		website = "https://" + website
+	}
+
+	base, err := url.Parse(website)
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return "", nil
+	}
+
+	bz, err := r.http.Get(base.String(), map[string]string{"Accept": "text/html"})
+	if errors.Is(err, httpclient.ErrNonPublicAddress) {
+		// Websites hosted on internal addresses are never read, so they have no avatar
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return findImageURL(base, string(bz)), nil
+}
+
+// findImageURL returns the absolute URL of the image best representing the given page,
+// preferring the Open Graph image to the touch icon and to the plain icon
+func findImageURL(base *url.URL, page string) string {
+	var ogImage, touchIcon, icon string
+	for _, tag := range tagRegExp.FindAllString(page, -1) {
+		attributes := parseAttributes(tag)
+
+		switch {
+		case attributes["property"] == "og:image" || attributes["name"] == "og:image":
+			if ogImage == "" {
+				ogImage = attributes["content"]
+			}
+
+		case hasRel(attributes["rel"], "apple-touch-icon"):
+			if touchIcon == "" {
+				touchIcon = attributes["href"]
+			}
+
+		case hasRel(attributes["rel"], "icon"):
+			if icon == "" {
+				icon = attributes["href"]
+			}
+		}
+	}
+
+	for _, candidate := range []string{ogImage, touchIcon, icon} {
+		if candidate == "" {
+			continue
+		}
+
+		ref, err := url.Parse(strings.TrimSpace(candidate))
+		if err != nil {
+			continue
+		}
+
+		resolved := base.ResolveReference(ref)
+		if resolved.Scheme == "http" || resolved.Scheme == "https" {
+			return resolved.String()
+		}
+	}
+
+	return ""
+}
+
+// parseAttributes returns the lowercase attributes names of the given tag along with their values
+func parseAttributes(tag string) map[string]string {
+	attributes := make(map[string]string)
+	for _, match := range attributeRegExp.FindAllStringSubmatch(tag, -1) {
+		value := match[2]
+		if value == "" {
+			value = match[3]
+		}
+		attributes[strings.ToLower(match[1])] = value
+	}
+	return attributes
+}
+
+// hasRel tells whether the given rel attribute contains the given value
+func hasRel(rel string, value string) bool {
+	for _, field := range strings.Fields(strings.ToLower(rel)) {
+		if field == value {
+			return true
+		}
+	}
+	return false
+}
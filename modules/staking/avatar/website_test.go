@@ -0,0 +1,94 @@
+package avatar
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
+)
+
+func TestWebsiteResolver_GetAvatarURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head>
+<link rel="shortcut icon" href="/favicon.png">
+<meta property='og:image' content="/images/logo.png" />
+</head></html>`))
+	}))
+	defer server.Close()
+
+	// The test server listens on the loopback address, so the unrestricted client is used
+	resolver := &WebsiteResolver{http: httpclient.NewClient("test", &httpclient.Config{MaxRetries: -1})}
+
+	avatarURL, err := resolver.GetAvatarURL("", stakingtypes.Description{Website: server.URL + "/about"})
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/images/logo.png", avatarURL)
+
+	avatarURL, err = resolver.GetAvatarURL("", stakingtypes.Description{})
+	require.NoError(t, err)
+	require.Empty(t, avatarURL)
+}
+
+func TestWebsiteResolver_GetAvatarURL_NonPublicAddress(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.Write([]byte(`<link rel="icon" href="/favicon.png">`))
+	}))
+	defer server.Close()
+
+	resolver := NewWebsiteResolver(httpclient.NewClient("test", &httpclient.Config{MaxRetries: -1}))
+
+	avatarURL, err := resolver.GetAvatarURL("", stakingtypes.Description{Website: server.URL})
+	require.NoError(t, err)
+	require.Empty(t, avatarURL)
+	require.False(t, called)
+}
+
+func TestFindImageURL(t *testing.T) {
+	base, err := url.Parse("https://validator.example.com/team/")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		page     string
+		expected string
+	}{
+		{
+			name:     "touch icon preferred to icon",
+			page:     `<link rel="icon" href="favicon.ico"><link rel="apple-touch-icon" href="/touch.png">`,
+			expected: "https://validator.example.com/touch.png",
+		},
+		{
+			name:     "relative icon",
+			page:     `<LINK REL="Icon" HREF="icon.svg">`,
+			expected: "https://validator.example.com/team/icon.svg",
+		},
+		{
+			name:     "absolute image",
+			page:     `<meta name="og:image" content="https://cdn.example.com/logo.png">`,
+			expected: "https://cdn.example.com/logo.png",
+		},
+		{
+			name:     "non http image",
+			page:     `<link rel="icon" href="data:image/png;base64,AAAA">`,
+			expected: "",
+		},
+		{
+			name:     "no image",
+			page:     `<html><body>validator</body></html>`,
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, findImageURL(base, tc.page))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package staking
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/bdjuno/v4/modules/staking/avatar"
+)
+
+const (
+	DefaultAvatarRefreshInterval = 24 * time.Hour
+)
+
+// Config contains the configuration about the staking module
+type Config struct {
+	Avatar AvatarConfig `yaml:"avatar"`
+}
+
+// AvatarConfig contains the configuration about how the validator avatars are resolved
+type AvatarConfig struct {
+	// Resolvers contains the names of the resolvers queried in order: keybase, static or website
+	Resolvers []string `yaml:"resolvers,omitempty"`
+	// StaticFile is the YAML file mapping operator addresses or identities to avatar URLs, used by the static resolver
+	StaticFile string `yaml:"static_file,omitempty"`
+	// RefreshInterval is how often every validator avatar is resolved again
+	RefreshInterval time.Duration `yaml:"refresh_interval,omitempty"`
+}
+
+// DefaultConfig returns the configuration used when the staking section is not set
+func DefaultConfig() *Config {
+	cfg := &Config{}
+	cfg.Validate()
+	return cfg
+}
+
+// Validate sets the default values of the fields that are not set
+func (cfg *Config) Validate() {
+	if len(cfg.Avatar.Resolvers) == 0 {
+		cfg.Avatar.Resolvers = []string{avatar.ResolverKeybase}
+	}
+
+	if cfg.Avatar.RefreshInterval <= 0 {
+		cfg.Avatar.RefreshInterval = DefaultAvatarRefreshInterval
+	}
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"staking"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	cfg.Config.Validate()
+	return cfg.Config, nil
+}
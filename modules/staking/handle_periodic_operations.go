@@ -27,6 +27,20 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while setting up gov period operations: %s", err)
 	}
 
+	// Resolve the avatars of the validators whose identity or website changed every 5 mins
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(m.ResolveStaleAvatars)
+	}); err != nil {
+		return fmt.Errorf("error while setting up stale avatars period operations: %s", err)
+	}
+
+	// Refresh the validators avatars as configured
+	if _, err := scheduler.Every(m.cfg.Avatar.RefreshInterval).Do(func() {
+		utils.WatchMethod(m.RefreshValidatorsAvatars)
+	}); err != nil {
+		return fmt.Errorf("error while setting up avatars period operations: %s", err)
+	}
+
 	return nil
 }
 
@@ -1,10 +1,17 @@
 package keybase
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"net/url"
+
+	"github.com/forbole/bdjuno/v4/modules/httpclient"
+)
+
+const (
+	// Upstream represents the name of the Keybase upstream inside the http_clients configuration
+	Upstream = "keybase"
+
+	baseURL = "https://keybase.io/_/api/1.0"
 )
 
 // GetAvatarURL returns the avatar URL from the given identity.
@@ -15,7 +22,7 @@ func GetAvatarURL(identity string) (string, error) {
 	}
 
 	var response IdentityQueryResponse
-	endpoint := fmt.Sprintf("/user/lookup.json?key_suffix=%[1]s&fields=basics&fields=pictures", identity)
+	endpoint := fmt.Sprintf("/user/lookup.json?key_suffix=%[1]s&fields=basics&fields=pictures", url.QueryEscape(identity))
 	err := queryKeyBase(endpoint, &response)
 	if err != nil {
 		return "", fmt.Errorf("error while querying keybase: %s", err)
@@ -44,22 +51,10 @@ func GetAvatarURL(identity string) (string, error) {
 // queryKeyBase queries the Keybase APIs for the given endpoint, and de-serializes
 // the response as a JSON object inside the given ptr
 func queryKeyBase(endpoint string, ptr interface{}) error {
-	resp, err := http.Get("https://keybase.io/_/api/1.0" + endpoint)
+	err := httpclient.Upstream(Upstream).GetJSON(baseURL+endpoint, nil, ptr)
 	if err != nil {
 		return fmt.Errorf("error while querying keybase APIs: %s", err)
 	}
 
-	defer resp.Body.Close()
-
-	bz, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("error while reading response body: %s", err)
-	}
-
-	err = json.Unmarshal(bz, &ptr)
-	if err != nil {
-		return fmt.Errorf("error while unmarshaling response body: %s", err)
-	}
-
 	return nil
 }
@@ -3,8 +3,10 @@ package staking
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
 
 	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/staking/avatar"
 	stakingsource "github.com/forbole/bdjuno/v4/modules/staking/source"
 )
 
@@ -18,19 +20,38 @@ var (
 
 // Module represents the x/staking module
 type Module struct {
-	cdc    codec.Codec
-	db     *database.Db
-	source stakingsource.Source
+	cfg     *Config
+	cdc     codec.Codec
+	db      *database.Db
+	source  stakingsource.Source
+	avatars *avatar.Chain
 }
 
 // NewModule returns a new Module instance
 func NewModule(
-	source stakingsource.Source, cdc codec.Codec, db *database.Db,
+	cfg config.Config, source stakingsource.Source, cdc codec.Codec, db *database.Db,
 ) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	stakingCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	avatars, err := avatar.NewChain(stakingCfg.Avatar.Resolvers, stakingCfg.Avatar.StaticFile)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
-		cdc:    cdc,
-		db:     db,
-		source: source,
+		cfg:     stakingCfg,
+		cdc:     cdc,
+		db:      db,
+		source:  source,
+		avatars: avatars,
 	}
 }
 
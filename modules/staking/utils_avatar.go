@@ -0,0 +1,91 @@
+package staking
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// getAvatarURL returns the avatar URL of the validator having the given operator address.
+// Avatars are never resolved while handling messages, since the resolvers reach external services: the stored
+// avatar is returned instead, and the validators whose identity or website changed are resolved later on
+// by ResolveStaleAvatars.
+func (m *Module) getAvatarURL(opAddr string) (string, error) {
+	cached, err := m.db.GetValidatorAvatar(opAddr)
+	if err != nil {
+		return "", err
+	}
+
+	if cached == nil {
+		return "", nil
+	}
+
+	return cached.AvatarURL, nil
+}
+
+// RefreshValidatorsAvatars resolves again the avatars of all the validators,
+// and updates their descriptions with the new ones
+func (m *Module) RefreshValidatorsAvatars() error {
+	log.Debug().Str("module", "staking").Msg("refreshing validators avatars")
+
+	descriptions, err := m.db.GetValidatorsDescriptions()
+	if err != nil {
+		return err
+	}
+
+	return m.refreshAvatars(descriptions)
+}
+
+// ResolveStaleAvatars resolves the avatars of the validators that have never been resolved, or whose
+// identity or website changed since the last time, and updates their descriptions with the new ones
+func (m *Module) ResolveStaleAvatars() error {
+	descriptions, err := m.db.GetValidatorsDescriptionsWithStaleAvatar()
+	if err != nil {
+		return err
+	}
+
+	if len(descriptions) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "staking").Int("validators", len(descriptions)).Msg("resolving stale validators avatars")
+	return m.refreshAvatars(descriptions)
+}
+
+// refreshAvatars resolves the avatars of the given validators, storing them and updating their descriptions.
+// When the avatar of a validator can not be resolved reliably its previous one is kept.
+func (m *Module) refreshAvatars(descriptions []types.ValidatorDescription) error {
+	for _, description := range descriptions {
+		avatarURL, source, err := m.avatars.GetAvatarURL(description.OperatorAddress, description.Description)
+		if err != nil {
+			log.Warn().Str("module", "staking").Str("validator", description.OperatorAddress).Err(err).
+				Msg("error while resolving validator avatar, keeping the previous one")
+			continue
+		}
+
+		err = m.db.SaveValidatorAvatar(types.NewValidatorAvatar(
+			description.OperatorAddress,
+			description.Description.Identity,
+			description.Description.Website,
+			avatarURL,
+			source,
+			time.Now(),
+		))
+		if err != nil {
+			return err
+		}
+
+		if avatarURL == description.AvatarURL {
+			continue
+		}
+
+		err = m.db.UpdateValidatorAvatarURL(description.OperatorAddress, avatarURL)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
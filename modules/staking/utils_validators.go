@@ -3,7 +3,6 @@ package staking
 import (
 	"fmt"
 
-	"github.com/forbole/bdjuno/v4/modules/staking/keybase"
 	"github.com/forbole/bdjuno/v4/types"
 
 	"github.com/rs/zerolog/log"
@@ -55,8 +54,8 @@ func (m *Module) convertValidator(height int64, validator stakingtypes.Validator
 	), nil
 }
 
-// convertValidatorDescription returns a new types.ValidatorDescription object by fetching the avatar URL
-// using the Keybase APIs
+// convertValidatorDescription returns a new types.ValidatorDescription object by getting the avatar URL
+// from the configured resolvers
 func (m *Module) convertValidatorDescription(
 	height int64, opAddr string, description stakingtypes.Description,
 ) types.ValidatorDescription {
@@ -65,7 +64,7 @@ func (m *Module) convertValidatorDescription(
 	if description.Identity == stakingtypes.DoNotModifyDesc {
 		avatarURL = stakingtypes.DoNotModifyDesc
 	} else {
-		url, err := keybase.GetAvatarURL(description.Identity)
+		url, err := m.getAvatarURL(opAddr)
 		if err != nil {
 			url = ""
 		}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -22,6 +23,7 @@ import (
 	nodeconfig "github.com/forbole/juno/v5/node/config"
 	"github.com/forbole/juno/v5/node/local"
 	"github.com/forbole/juno/v5/node/remote"
+	"google.golang.org/grpc"
 
 	banksource "github.com/forbole/bdjuno/v4/modules/bank/source"
 	localbanksource "github.com/forbole/bdjuno/v4/modules/bank/source/local"
@@ -34,6 +36,7 @@ import (
 	mintsource "github.com/forbole/bdjuno/v4/modules/mint/source"
 	localmintsource "github.com/forbole/bdjuno/v4/modules/mint/source/local"
 	remotemintsource "github.com/forbole/bdjuno/v4/modules/mint/source/remote"
+	"github.com/forbole/bdjuno/v4/modules/nodepool"
 	overgoldAllowedSource "github.com/forbole/bdjuno/v4/modules/overgold/chain/allowed/source"
 	remoteOvergoldAllowedSource "github.com/forbole/bdjuno/v4/modules/overgold/chain/allowed/source/remote"
 	overgoldBankSource "github.com/forbole/bdjuno/v4/modules/overgold/chain/bank/source"
@@ -71,6 +74,22 @@ type Sources struct {
 
 	// Custom SDK sources
 	OverGoldBankSource overgoldBankSource.Source
+
+	// grpcSource and grpcConn are used to rebuild the remote sources bound to a different context
+	grpcSource *remote.Source
+	grpcConn   grpc.ClientConnInterface
+}
+
+// WithContext returns a copy of the sources whose node queries are bound to the given context, so that
+// they are cancelled along with it. Local sources do not query any node, so they are returned unchanged.
+func (s *Sources) WithContext(ctx context.Context) *Sources {
+	if s == nil || s.grpcSource == nil {
+		return s
+	}
+
+	source := *s.grpcSource
+	source.Ctx = ctx
+	return buildGrpcSources(&source, s.grpcConn)
 }
 
 func BuildSources(nodeCfg nodeconfig.Config, encodingConfig *params.EncodingConfig) (*Sources, error) {
@@ -134,20 +153,32 @@ func buildRemoteSources(cfg *remote.Details) (*Sources, error) {
 		return nil, fmt.Errorf("error while creating remote source: %s", err)
 	}
 
+	return buildGrpcSources(source, source.GrpcConn), nil
+}
+
+// BuildPoolSources builds the remote sources sending each query to one of the nodes of the given pool
+func BuildPoolSources(pool *nodepool.Pool) *Sources {
+	return buildGrpcSources(&remote.Source{Ctx: context.Background()}, pool)
+}
+
+func buildGrpcSources(source *remote.Source, conn grpc.ClientConnInterface) *Sources {
 	return &Sources{
-		BankSource:     remotebanksource.NewSource(source, banktypes.NewQueryClient(source.GrpcConn)),
-		DistrSource:    remotedistrsource.NewSource(source, distrtypes.NewQueryClient(source.GrpcConn)),
-		GovSource:      remotegovsource.NewSource(source, govtypesv1.NewQueryClient(source.GrpcConn)),
-		MintSource:     remotemintsource.NewSource(source, minttypes.NewQueryClient(source.GrpcConn)),
-		SlashingSource: remoteslashingsource.NewSource(source, slashingtypes.NewQueryClient(source.GrpcConn)),
-		StakingSource:  remotestakingsource.NewSource(source, stakingtypes.NewQueryClient(source.GrpcConn)),
+		grpcSource: source,
+		grpcConn:   conn,
+
+		BankSource:     remotebanksource.NewSource(source, banktypes.NewQueryClient(conn)),
+		DistrSource:    remotedistrsource.NewSource(source, distrtypes.NewQueryClient(conn)),
+		GovSource:      remotegovsource.NewSource(source, govtypesv1.NewQueryClient(conn)),
+		MintSource:     remotemintsource.NewSource(source, minttypes.NewQueryClient(conn)),
+		SlashingSource: remoteslashingsource.NewSource(source, slashingtypes.NewQueryClient(conn)),
+		StakingSource:  remotestakingsource.NewSource(source, stakingtypes.NewQueryClient(conn)),
 
 		// Custom OVG sources
-		OverGoldAllowedSource:     remoteOvergoldAllowedSource.NewSource(source, allowedtypes.NewQueryClient(source.GrpcConn)),
-		OverGoldBankSource:        remoteOvergoldBankSource.NewSource(source, banktypes.NewQueryClient(source.GrpcConn)),
-		OverGoldCoreSource:        remoteOvergoldCoreSource.NewSource(source, coretypes.NewQueryClient(source.GrpcConn)),
-		OverGoldFeeExcluderSource: remoteOvergoldFeeExcluderSource.NewSource(source, feeexcludertypes.NewQueryClient(source.GrpcConn)),
-		OverGoldReferralSource:    remoteOvergoldReferralSource.NewSource(source, referraltypes.NewQueryClient(source.GrpcConn)),
-		OverGoldStakeSource:       remoteOvergoldStakeSource.NewSource(source, staketypes.NewQueryClient(source.GrpcConn)),
-	}, nil
+		OverGoldAllowedSource:     remoteOvergoldAllowedSource.NewSource(source, allowedtypes.NewQueryClient(conn)),
+		OverGoldBankSource:        remoteOvergoldBankSource.NewSource(source, banktypes.NewQueryClient(conn)),
+		OverGoldCoreSource:        remoteOvergoldCoreSource.NewSource(source, coretypes.NewQueryClient(conn)),
+		OverGoldFeeExcluderSource: remoteOvergoldFeeExcluderSource.NewSource(source, feeexcludertypes.NewQueryClient(conn)),
+		OverGoldReferralSource:    remoteOvergoldReferralSource.NewSource(source, referraltypes.NewQueryClient(conn)),
+		OverGoldStakeSource:       remoteOvergoldStakeSource.NewSource(source, staketypes.NewQueryClient(conn)),
+	}
 }
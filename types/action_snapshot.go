@@ -0,0 +1,25 @@
+package types
+
+import (
+	"time"
+)
+
+// ActionSnapshot contains the latest response returned by the node for an action request
+type ActionSnapshot struct {
+	Path       string
+	RequestKey string
+	Response   []byte
+	Height     int64
+	UpdatedAt  time.Time
+}
+
+// NewActionSnapshot allows to build a new ActionSnapshot instance
+func NewActionSnapshot(path, requestKey string, response []byte, height int64, updatedAt time.Time) ActionSnapshot {
+	return ActionSnapshot{
+		Path:       path,
+		RequestKey: requestKey,
+		Response:   response,
+		Height:     height,
+		UpdatedAt:  updatedAt,
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import "time"
+
+const (
+	// ActivityRoleCreator identifies the address that signed a message
+	ActivityRoleCreator = "creator"
+
+	// ActivityRoleSender identifies the address that sent funds or stake
+	ActivityRoleSender = "sender"
+
+	// ActivityRoleReceiver identifies the address that received funds or stake
+	ActivityRoleReceiver = "receiver"
+
+	// ActivityRoleReferrer identifies the address that has been set as referrer
+	ActivityRoleReferrer = "referrer"
+
+	// ActivityRoleReferral identifies the address that has been referred
+	ActivityRoleReferral = "referral"
+
+	// ActivityRoleSubject identifies an address that has been managed by a message,
+	// e.g. added to the allowed list or excluded from fees
+	ActivityRoleSubject = "subject"
+)
+
+// AddressActivity represents the involvement of an address in a single message
+type AddressActivity struct {
+	TxHash    string
+	MsgIndex  int
+	Height    int64
+	Address   string
+	Role      string
+	Module    string
+	MsgType   string
+	Timestamp time.Time
+}
+
+// NewAddressActivity allows to build a new AddressActivity instance
+func NewAddressActivity(
+	txHash string, msgIndex int, height int64, address, role, module, msgType string, timestamp time.Time,
+) AddressActivity {
+	return AddressActivity{
+		TxHash:    txHash,
+		MsgIndex:  msgIndex,
+		Height:    height,
+		Address:   address,
+		Role:      role,
+		Module:    module,
+		MsgType:   msgType,
+		Timestamp: timestamp,
+	}
+}
+
+// AddressActivityFilter contains the filters that can be applied while querying the activity of an address
+type AddressActivityFilter struct {
+	Address  string
+	MsgTypes []string
+	Roles    []string
+	Offset   uint64
+	Limit    uint64
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"time"
+)
+
+// BlockGap represents a range of consecutive heights missing from the stored blocks
+type BlockGap struct {
+	StartHeight int64
+	EndHeight   int64
+}
+
+// NewBlockGap allows to build a new BlockGap instance
+func NewBlockGap(startHeight, endHeight int64) BlockGap {
+	return BlockGap{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// Size returns the number of heights contained inside the gap
+func (g BlockGap) Size() int64 {
+	return g.EndHeight - g.StartHeight + 1
+}
+
+// BlockGapReport contains the outcome of the refetch of a BlockGap
+type BlockGapReport struct {
+	BlockGap
+
+	// Source identifies what detected the gap, either a refetch window or the parse command
+	Source string
+
+	// Missing is the number of heights of the gap that could not be refetched
+	Missing int64
+
+	// Error is the first error returned while refetching the gap, if any
+	Error string
+
+	CheckedAt time.Time
+	FilledAt  *time.Time
+}
+
+// NewBlockGapReport allows to build a new BlockGapReport instance.
+// The gap is considered filled when no height is missing anymore.
+func NewBlockGapReport(gap BlockGap, source string, missing int64, err string, checkedAt time.Time) BlockGapReport {
+	var filledAt *time.Time
+	if missing == 0 {
+		filledAt = &checkedAt
+	}
+
+	return BlockGapReport{
+		BlockGap:  gap,
+		Source:    source,
+		Missing:   missing,
+		Error:     err,
+		CheckedAt: checkedAt,
+		FilledAt:  filledAt,
+	}
+}
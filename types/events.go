@@ -0,0 +1,109 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// TransferEvent represents a single transfer emitted while executing a message
+type TransferEvent struct {
+	TxHash     string
+	MsgIndex   int
+	EventIndex int
+	Sender     string
+	Recipient  string
+	Amount     sdk.Coins
+}
+
+// NewTransferEvent allows to build a new TransferEvent instance
+func NewTransferEvent(
+	txHash string, msgIndex, eventIndex int, sender, recipient string, amount sdk.Coins,
+) TransferEvent {
+	return TransferEvent{
+		TxHash:     txHash,
+		MsgIndex:   msgIndex,
+		EventIndex: eventIndex,
+		Sender:     sender,
+		Recipient:  recipient,
+		Amount:     amount,
+	}
+}
+
+// FeeChargedEvent represents a fee that has been actually charged while executing a message
+type FeeChargedEvent struct {
+	TxHash     string
+	MsgIndex   int
+	EventIndex int
+	Payer      string
+	Amount     sdk.Coins
+}
+
+// NewFeeChargedEvent allows to build a new FeeChargedEvent instance
+func NewFeeChargedEvent(txHash string, msgIndex, eventIndex int, payer string, amount sdk.Coins) FeeChargedEvent {
+	return FeeChargedEvent{
+		TxHash:     txHash,
+		MsgIndex:   msgIndex,
+		EventIndex: eventIndex,
+		Payer:      payer,
+		Amount:     amount,
+	}
+}
+
+// RewardPaidEvent represents a reward that has been actually paid while executing a message
+type RewardPaidEvent struct {
+	TxHash     string
+	MsgIndex   int
+	EventIndex int
+	Recipient  string
+	Amount     sdk.Coins
+}
+
+// NewRewardPaidEvent allows to build a new RewardPaidEvent instance
+func NewRewardPaidEvent(txHash string, msgIndex, eventIndex int, recipient string, amount sdk.Coins) RewardPaidEvent {
+	return RewardPaidEvent{
+		TxHash:     txHash,
+		MsgIndex:   msgIndex,
+		EventIndex: eventIndex,
+		Recipient:  recipient,
+		Amount:     amount,
+	}
+}
+
+// MsgEvents contains all the typed events emitted while executing a single message
+type MsgEvents struct {
+	Transfers   []TransferEvent
+	FeesCharged []FeeChargedEvent
+	RewardsPaid []RewardPaidEvent
+}
+
+// IsEmpty tells whether no typed event has been emitted
+func (e MsgEvents) IsEmpty() bool {
+	return len(e.Transfers) == 0 && len(e.FeesCharged) == 0 && len(e.RewardsPaid) == 0
+}
+
+// TotalRewardsPaid returns the sum of all the rewards paid to the given recipient.
+// If recipient is empty, the rewards paid to all recipients are summed up.
+func (e MsgEvents) TotalRewardsPaid(recipient string) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, reward := range e.RewardsPaid {
+		if recipient != "" && reward.Recipient != recipient {
+			continue
+		}
+
+		total = total.Add(reward.Amount...)
+	}
+
+	return total
+}
+
+// TotalFeesCharged returns the sum of all the fees charged to the given payer.
+// If payer is empty, the fees charged to all payers are summed up.
+func (e MsgEvents) TotalFeesCharged(payer string) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, fee := range e.FeesCharged {
+		if payer != "" && fee.Payer != payer {
+			continue
+		}
+
+		total = total.Add(fee.Amount...)
+	}
+
+	return total
+}
@@ -0,0 +1,39 @@
+package types
+
+import sdkmath "cosmossdk.io/math"
+
+// FeeQuote represents the fee breakdown of an OverGold transfer
+type FeeQuote struct {
+	Denom  string
+	Amount sdkmath.Int
+
+	// Excluded tells whether the transfer is exempt from fees
+	Excluded bool
+
+	// TariffID and FeesID identify the rule used to compute the fee, if any
+	TariffID uint64
+	FeesID   uint64
+
+	Fee          sdkmath.Int
+	RefReward    sdkmath.Int
+	StakeReward  sdkmath.Int
+	SystemReward sdkmath.Int
+}
+
+// NewZeroFeeQuote returns a FeeQuote for a transfer that does not pay any fee
+func NewZeroFeeQuote(denom string, amount sdkmath.Int, excluded bool) FeeQuote {
+	return FeeQuote{
+		Denom:        denom,
+		Amount:       amount,
+		Excluded:     excluded,
+		Fee:          sdkmath.ZeroInt(),
+		RefReward:    sdkmath.ZeroInt(),
+		StakeReward:  sdkmath.ZeroInt(),
+		SystemReward: sdkmath.ZeroInt(),
+	}
+}
+
+// Total returns the amount that will be debited from the sender
+func (q FeeQuote) Total() sdkmath.Int {
+	return q.Amount.Add(q.Fee)
+}
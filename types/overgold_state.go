@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// StateDiffMissing identifies an entry that exists on the node but has not been indexed
+	StateDiffMissing = "missing"
+
+	// StateDiffStale identifies an indexed entry that does not exist on the node anymore
+	StateDiffStale = "stale"
+
+	// StateDiffChanged identifies an entry whose indexed value is different from the node one
+	StateDiffChanged = "changed"
+)
+
+// OverGoldStateDiff represents a difference between the state indexed for an OverGold module
+// and the one returned by the node
+type OverGoldStateDiff struct {
+	Module  string
+	Key     string
+	Kind    string
+	Indexed string
+	Node    string
+}
+
+// NewOverGoldStateDiff allows to build a new OverGoldStateDiff instance
+func NewOverGoldStateDiff(module, key, kind, indexed, node string) OverGoldStateDiff {
+	return OverGoldStateDiff{
+		Module:  module,
+		Key:     key,
+		Kind:    kind,
+		Indexed: indexed,
+		Node:    node,
+	}
+}
+
+// DiffOverGoldState compares the indexed and node values of the given module, both indexed by their key,
+// returning the differences sorted by key
+func DiffOverGoldState(module string, indexed, node map[string]string) []OverGoldStateDiff {
+	var diffs []OverGoldStateDiff
+	for key, nodeValue := range node {
+		indexedValue, ok := indexed[key]
+		switch {
+		case !ok:
+			diffs = append(diffs, NewOverGoldStateDiff(module, key, StateDiffMissing, "", nodeValue))
+		case indexedValue != nodeValue:
+			diffs = append(diffs, NewOverGoldStateDiff(module, key, StateDiffChanged, indexedValue, nodeValue))
+		}
+	}
+
+	for key, indexedValue := range indexed {
+		if _, ok := node[key]; !ok {
+			diffs = append(diffs, NewOverGoldStateDiff(module, key, StateDiffStale, indexedValue, ""))
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+
+	return diffs
+}
+
+// CheckOverGoldStateOverwrite returns an error if the given differences would be overwritten with a node state
+// containing no entries, which would delete all the indexed ones. An empty node state is most likely caused
+// by the node not returning it rather than by the state being cleared, so it is never trusted.
+func CheckOverGoldStateOverwrite(diffs []OverGoldStateDiff, nodeEntries int) error {
+	if nodeEntries == 0 && len(diffs) > 0 {
+		return fmt.Errorf("the node returned no entries while %d are indexed, refusing to overwrite them", len(diffs))
+	}
+
+	return nil
+}
+
+// ReferralLink represents the current referrer of an address
+type ReferralLink struct {
+	ReferralAddress string
+	ReferrerAddress string
+	Height          int64
+}
+
+// NewReferralLink allows to build a new ReferralLink instance
+func NewReferralLink(referralAddress, referrerAddress string, height int64) ReferralLink {
+	return ReferralLink{
+		ReferralAddress: referralAddress,
+		ReferrerAddress: referrerAddress,
+		Height:          height,
+	}
+}
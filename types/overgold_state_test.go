@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func TestDiffOverGoldState(t *testing.T) {
+	indexed := map[string]string{
+		"same":    "1",
+		"changed": "1",
+		"stale":   "1",
+	}
+	node := map[string]string{
+		"same":    "1",
+		"changed": "2",
+		"missing": "3",
+	}
+
+	diffs := types.DiffOverGoldState("allowed", indexed, node)
+	require.Equal(t, []types.OverGoldStateDiff{
+		types.NewOverGoldStateDiff("allowed", "changed", types.StateDiffChanged, "1", "2"),
+		types.NewOverGoldStateDiff("allowed", "missing", types.StateDiffMissing, "", "3"),
+		types.NewOverGoldStateDiff("allowed", "stale", types.StateDiffStale, "1", ""),
+	}, diffs)
+
+	require.Empty(t, types.DiffOverGoldState("allowed", node, node))
+}
+
+func TestCheckOverGoldStateOverwrite(t *testing.T) {
+	indexed := map[string]string{"stale": "1"}
+
+	diffs := types.DiffOverGoldState("allowed", indexed, map[string]string{})
+	require.Error(t, types.CheckOverGoldStateOverwrite(diffs, 0))
+
+	diffs = types.DiffOverGoldState("allowed", indexed, map[string]string{"missing": "2"})
+	require.NoError(t, types.CheckOverGoldStateOverwrite(diffs, 1))
+
+	require.NoError(t, types.CheckOverGoldStateOverwrite(nil, 0))
+}
@@ -6,6 +6,9 @@ import "time"
 type Token struct {
 	Name  string      `yaml:"name"`
 	Units []TokenUnit `yaml:"units"`
+
+	// Provider is the name of the price provider used for the token units
+	Provider string `yaml:"provider,omitempty"`
 }
 
 func NewToken(name string, units []TokenUnit) Token {
@@ -32,20 +35,140 @@ func NewTokenUnit(denom string, exponent int, aliases []string, priceID string)
 	}
 }
 
-// TokenPrice represents the price at a given moment in time of a token unit
+const (
+	// DefaultCurrency represents the currency prices are quoted in when no other one is configured
+	DefaultCurrency = "usd"
+)
+
+// TokenPrice represents the price at a given moment in time of a token unit, quoted in a given currency
 type TokenPrice struct {
 	UnitName  string
+	Currency  string
 	Price     float64
 	MarketCap int64
 	Timestamp time.Time
 }
 
 // NewTokenPrice returns a new TokenPrice instance containing the given data
-func NewTokenPrice(unitName string, price float64, marketCap int64, timestamp time.Time) TokenPrice {
+func NewTokenPrice(unitName string, currency string, price float64, marketCap int64, timestamp time.Time) TokenPrice {
 	return TokenPrice{
 		UnitName:  unitName,
+		Currency:  currency,
 		Price:     price,
 		MarketCap: marketCap,
 		Timestamp: timestamp,
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+const (
+	// TroyOunceGrams represents the amount of grams inside a troy ounce, the unit gold spot prices refer to
+	TroyOunceGrams = 31.1034768
+)
+
+// GoldPrice represents the gold spot price per troy ounce at a given moment in time
+type GoldPrice struct {
+	Price     float64
+	Timestamp time.Time
+}
+
+// NewGoldPrice returns a new GoldPrice instance containing the given data
+func NewGoldPrice(price float64, timestamp time.Time) GoldPrice {
+	return GoldPrice{
+		Price:     price,
+		Timestamp: timestamp,
+	}
+}
+
+// PricePerGram returns the gold spot price of a single gram
+func (p GoldPrice) PricePerGram() float64 {
+	return p.Price / TroyOunceGrams
+}
+
+// TokenNAV represents the theoretical value of a gold-backed token unit based on the gold spot price
+type TokenNAV struct {
+	UnitName     string
+	GramsPerUnit float64
+	SpotPrice    float64
+	NAV          float64
+	Timestamp    time.Time
+}
+
+// NewTokenNAV returns a new TokenNAV instance for the unit backed by the given grams of gold
+func NewTokenNAV(unitName string, gramsPerUnit float64, spot GoldPrice) TokenNAV {
+	return TokenNAV{
+		UnitName:     unitName,
+		GramsPerUnit: gramsPerUnit,
+		SpotPrice:    spot.Price,
+		NAV:          gramsPerUnit * spot.PricePerGram(),
+		Timestamp:    spot.Timestamp,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+const (
+	CandlePeriodHourly = "hourly"
+	CandlePeriodDaily  = "daily"
+)
+
+// CandlePeriods contains the periods the price candles are built for, along with their duration
+var CandlePeriods = map[string]time.Duration{
+	CandlePeriodHourly: time.Hour,
+	CandlePeriodDaily:  24 * time.Hour,
+}
+
+// TokenPriceCandle represents the OHLC values of a token unit price during a given period.
+// OpenTime and CloseTime are the timestamps of the first and last prices inside the period,
+// and allow to merge candles built from different sets of prices.
+type TokenPriceCandle struct {
+	UnitName  string
+	Currency  string
+	Period    string
+	Timestamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	OpenTime  time.Time
+	CloseTime time.Time
+}
+
+// NewTokenPriceCandle returns a new TokenPriceCandle for the period starting at the given timestamp,
+// containing the given price only
+func NewTokenPriceCandle(period string, timestamp time.Time, price TokenPrice) TokenPriceCandle {
+	return TokenPriceCandle{
+		UnitName:  price.UnitName,
+		Currency:  price.Currency,
+		Period:    period,
+		Timestamp: timestamp,
+		Open:      price.Price,
+		High:      price.Price,
+		Low:       price.Price,
+		Close:     price.Price,
+		OpenTime:  price.Timestamp,
+		CloseTime: price.Timestamp,
+	}
+}
+
+// Add updates the candle with the given price
+func (c *TokenPriceCandle) Add(price TokenPrice) {
+	if price.Timestamp.Before(c.OpenTime) {
+		c.Open = price.Price
+		c.OpenTime = price.Timestamp
+	}
+
+	if !price.Timestamp.Before(c.CloseTime) {
+		c.Close = price.Price
+		c.CloseTime = price.Timestamp
+	}
+
+	if price.Price > c.High {
+		c.High = price.Price
+	}
+
+	if price.Price < c.Low {
+		c.Low = price.Price
+	}
+}
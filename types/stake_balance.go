@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// StakeBalanceChange represents the change to the stake of an address caused by a single message.
+// Amount is the change to the staked amount, while SellAmount is the change to the amount put on sale.
+type StakeBalanceChange struct {
+	TxHash     string
+	MsgIndex   int
+	Height     int64
+	Address    string
+	Amount     sdkmath.Int
+	SellAmount sdkmath.Int
+}
+
+// NewStakeBalanceChange allows to build a new StakeBalanceChange instance
+func NewStakeBalanceChange(
+	txHash string, msgIndex int, height int64, address string, amount, sellAmount sdkmath.Int,
+) StakeBalanceChange {
+	return StakeBalanceChange{
+		TxHash:     txHash,
+		MsgIndex:   msgIndex,
+		Height:     height,
+		Address:    address,
+		Amount:     amount,
+		SellAmount: sellAmount,
+	}
+}
+
+// StakeBalance represents the current stake of an address
+type StakeBalance struct {
+	Address    string
+	Amount     sdkmath.Int
+	SellAmount sdkmath.Int
+	Height     int64
+}
+
+// NewStakeBalance allows to build a new StakeBalance instance
+func NewStakeBalance(address string, amount, sellAmount sdkmath.Int, height int64) StakeBalance {
+	return StakeBalance{
+		Address:    address,
+		Amount:     amount,
+		SellAmount: sellAmount,
+		Height:     height,
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StakeRewardKindAccrual identifies a reward credited to an address by a distribution
+	StakeRewardKindAccrual = "accrual"
+
+	// StakeRewardKindClaim identifies a reward withdrawn by an address
+	StakeRewardKindClaim = "claim"
+)
+
+// StakeRewardEntry represents a single movement of the stake rewards of an address
+type StakeRewardEntry struct {
+	TxHash    string
+	MsgIndex  int
+	Height    int64
+	Address   string
+	Kind      string
+	Amount    sdk.Coin
+	Timestamp time.Time
+}
+
+// NewStakeRewardEntry allows to build a new StakeRewardEntry instance
+func NewStakeRewardEntry(
+	txHash string, msgIndex int, height int64, address, kind string, amount sdk.Coin, timestamp time.Time,
+) StakeRewardEntry {
+	return StakeRewardEntry{
+		TxHash:    txHash,
+		MsgIndex:  msgIndex,
+		Height:    height,
+		Address:   address,
+		Kind:      kind,
+		Amount:    amount,
+		Timestamp: timestamp,
+	}
+}
+
+// StakeRewardBalance represents the rewards accrued and claimed by an address in a single denom
+type StakeRewardBalance struct {
+	Address string
+	Accrued sdk.Coin
+	Claimed sdk.Coin
+	Height  int64
+}
+
+// NewStakeRewardBalance allows to build a new StakeRewardBalance instance
+func NewStakeRewardBalance(address string, accrued, claimed sdk.Coin, height int64) StakeRewardBalance {
+	return StakeRewardBalance{
+		Address: address,
+		Accrued: accrued,
+		Claimed: claimed,
+		Height:  height,
+	}
+}
+
+// Pending returns the rewards that have been accrued but not claimed yet
+func (b StakeRewardBalance) Pending() sdk.Coin {
+	if b.Claimed.Amount.GTE(b.Accrued.Amount) {
+		return sdk.NewCoin(b.Accrued.Denom, sdk.ZeroInt())
+	}
+
+	return b.Accrued.Sub(b.Claimed)
+}
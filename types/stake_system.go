@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// SystemStakeAccountActionCreate identifies the registration of a system stake account
+	SystemStakeAccountActionCreate = "create"
+
+	// SystemStakeAccountActionUpdate identifies the replacement of the system stake account
+	SystemStakeAccountActionUpdate = "update"
+
+	// SystemStakeAccountActionDelete identifies the removal of the system stake account
+	SystemStakeAccountActionDelete = "delete"
+)
+
+// SystemStakeAccount represents the current state of an account used by the system to hold stake
+type SystemStakeAccount struct {
+	Address       string
+	Kind          string
+	Active        bool
+	CreatedHeight int64
+	UpdatedHeight int64
+	DeletedHeight int64
+}
+
+// SystemStakeAccountChange represents a single change of the system stake account registry
+type SystemStakeAccountChange struct {
+	TxHash          string
+	MsgIndex        int
+	Height          int64
+	Action          string
+	Creator         string
+	Address         string
+	PreviousAddress string
+	Timestamp       time.Time
+}
+
+// NewSystemStakeAccountChange allows to build a new SystemStakeAccountChange instance
+func NewSystemStakeAccountChange(
+	txHash string, msgIndex int, height int64, action, creator, address string, timestamp time.Time,
+) SystemStakeAccountChange {
+	return SystemStakeAccountChange{
+		TxHash:    txHash,
+		MsgIndex:  msgIndex,
+		Height:    height,
+		Action:    action,
+		Creator:   creator,
+		Address:   address,
+		Timestamp: timestamp,
+	}
+}
+
+// SystemStakeChange represents an amount of stake managed by the system for the given kind
+type SystemStakeChange struct {
+	TxHash   string
+	MsgIndex int
+	Height   int64
+	Kind     string
+	Amount   sdkmath.Int
+}
+
+// NewSystemStakeChange allows to build a new SystemStakeChange instance
+func NewSystemStakeChange(txHash string, msgIndex int, height int64, kind string, amount sdkmath.Int) SystemStakeChange {
+	return SystemStakeChange{
+		TxHash:   txHash,
+		MsgIndex: msgIndex,
+		Height:   height,
+		Kind:     kind,
+		Amount:   amount,
+	}
+}
+
+// SystemStakeTotal represents the total amount of stake managed by the system for a kind at a given height
+type SystemStakeTotal struct {
+	Kind    string
+	Address string
+	Total   sdkmath.Int
+	Height  int64
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// StakeTransferSourceFromUser identifies a stake transfer made with MsgTransferFromUser
+	StakeTransferSourceFromUser = "from_user"
+
+	// StakeTransferSourceToUser identifies a stake transfer made with MsgTransferToUser
+	StakeTransferSourceToUser = "to_user"
+
+	// StakeFlowDirectionIn identifies stake received by an address
+	StakeFlowDirectionIn = "in"
+
+	// StakeFlowDirectionOut identifies stake sent by an address
+	StakeFlowDirectionOut = "out"
+)
+
+// StakeTransfer represents a single movement of stake between two addresses
+type StakeTransfer struct {
+	TxHash    string
+	MsgIndex  int
+	Source    string
+	Height    int64
+	From      string
+	To        string
+	Amount    sdkmath.Int
+	Timestamp time.Time
+}
+
+// NewStakeTransfer allows to build a new StakeTransfer instance
+func NewStakeTransfer(
+	txHash string, msgIndex int, source string, height int64, from, to string, amount sdkmath.Int, timestamp time.Time,
+) StakeTransfer {
+	return StakeTransfer{
+		TxHash:    txHash,
+		MsgIndex:  msgIndex,
+		Source:    source,
+		Height:    height,
+		From:      from,
+		To:        to,
+		Amount:    amount,
+		Timestamp: timestamp,
+	}
+}
+
+// StakeFlow contains the amounts of stake received and sent by an address
+type StakeFlow struct {
+	In  sdkmath.Int
+	Out sdkmath.Int
+}
+
+// Net returns the difference between the received and the sent stake
+func (f StakeFlow) Net() sdkmath.Int {
+	return f.In.Sub(f.Out)
+}
+
+// StakeDailyFlow represents the stake moved by an address during a single day
+type StakeDailyFlow struct {
+	StakeFlow
+	Address string
+	Date    time.Time
+}
+
+// StakeCounterpartyFlow represents the stake moved between an address and one of its counterparties
+type StakeCounterpartyFlow struct {
+	StakeFlow
+	Address      string
+	Counterparty string
+	Transfers    uint64
+}
+
+// StakeTotalFlow represents the cumulative stake moved by an address
+type StakeTotalFlow struct {
+	StakeFlow
+	Address string
+	Height  int64
+}
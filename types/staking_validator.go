@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -101,6 +103,33 @@ func NewValidatorDescription(
 
 // ----------------------------------------------------------------------------------------------------------
 
+// ValidatorAvatar contains the avatar resolved for a validator, along with the identity and website
+// it was resolved from, so that it is resolved again only when they change
+type ValidatorAvatar struct {
+	OperatorAddress string
+	Identity        string
+	Website         string
+	AvatarURL       string
+	Source          string
+	UpdatedAt       time.Time
+}
+
+// NewValidatorAvatar returns a new ValidatorAvatar instance
+func NewValidatorAvatar(
+	opAddr string, identity string, website string, avatarURL string, source string, updatedAt time.Time,
+) ValidatorAvatar {
+	return ValidatorAvatar{
+		OperatorAddress: opAddr,
+		Identity:        identity,
+		Website:         website,
+		AvatarURL:       avatarURL,
+		Source:          source,
+		UpdatedAt:       updatedAt,
+	}
+}
+
+// ----------------------------------------------------------------------------------------------------------
+
 // ValidatorCommission contains the data of a validator commission at a given height
 type ValidatorCommission struct {
 	ValAddress        string